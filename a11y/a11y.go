@@ -0,0 +1,372 @@
+// Package a11y checks rendered HTML for common accessibility mistakes, like inputs without labels, buttons without
+// accessible names, images without alt text, headings that skip levels and invalid aria-* attributes.  Each finding
+// includes a CSS selector for the element, so it can be found in the page or reported by a failing test.
+//
+// These checks are heuristics, not an audit: they catch the mistakes that are easy to make when generating markup,
+// but cannot tell if an image's alt text is useful or a page makes sense with a screen reader.
+package a11y
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/internal/aria"
+	"github.com/swdunlop/html-go/tag"
+)
+
+// A Rule identifies one of the checks.
+type Rule string
+
+const (
+	ImageAlt     Rule = `image-alt`     // images, image inputs and image map areas must have alt text
+	Label        Rule = `label`         // form controls must have a label or an accessible name
+	ButtonName   Rule = `button-name`   // buttons must have text or an accessible name
+	HeadingOrder Rule = `heading-order` // headings must not skip levels, like an "h3" after an "h1"
+	ARIA         Rule = `aria`          // aria-* attributes must be defined by WAI-ARIA, with valid values
+)
+
+// A Finding describes an accessibility problem with an element.
+type Finding struct {
+	Rule     Rule   // Rule is the check that found the problem.
+	Selector string // Selector is a CSS selector for the element, like "form#login > input:nth-of-type(2)".
+	Message  string // Message describes the problem, like "<img> has no alt attribute".
+}
+
+// String returns the finding as a single line, like `image-alt: main > img: <img> has no alt attribute`.
+func (f Finding) String() string {
+	return fmt.Sprintf(`%v: %v: %v`, f.Rule, f.Selector, f.Message)
+}
+
+// An Option changes what is checked.
+type Option func(*config)
+
+// Ignore skips the rules, which is useful while fixing an existing page one rule at a time.
+func Ignore(rules ...Rule) Option {
+	return func(cfg *config) { cfg.ignored = append(cfg.ignored, rules...) }
+}
+
+type config struct {
+	ignored []Rule
+}
+
+// Check renders the content and returns an error listing any findings, or nil if there are none, which is convenient
+// in tests.
+func Check(content html.Content, options ...Option) error {
+	findings := Content(context.Background(), content, options...)
+	if len(findings) == 0 {
+		return nil
+	}
+	errs := make([]error, len(findings))
+	for i, finding := range findings {
+		errs[i] = errors.New(finding.String())
+	}
+	return errors.Join(errs...)
+}
+
+// Content renders the content with ctx and returns any findings, in document order.
+func Content(ctx context.Context, content html.Content, options ...Option) []Finding {
+	return HTML(html.AppendContext(ctx, nil, content), options...)
+}
+
+// HTML returns any findings in HTML that has already been rendered, in document order.
+func HTML(src []byte, options ...Option) []Finding {
+	var cfg config
+	for _, option := range options {
+		option(&cfg)
+	}
+	group, _ := tag.Parse(bytes.NewReader(src)) // reading from memory cannot fail.
+	c := checker{config: cfg, ids: make(map[string]bool), labelled: make(map[string]bool)}
+	c.index(group)
+	c.children(group, scope{})
+	return c.findings
+}
+
+type checker struct {
+	config
+	ids      map[string]bool // the IDs used in the page
+	labelled map[string]bool // the IDs named by the "for" attribute of a label
+	heading  int             // the level of the last heading, or zero before the first one
+	findings []Finding
+}
+
+func (c *checker) report(rule Rule, selector string, format string, args ...any) {
+	if slices.Contains(c.ignored, rule) {
+		return
+	}
+	c.findings = append(c.findings, Finding{Rule: rule, Selector: selector, Message: fmt.Sprintf(format, args...)})
+}
+
+// index collects the IDs in the page and the controls named by labels, since a label can follow its control.
+func (c *checker) index(content html.Content) {
+	html.Walk(content, func(n html.Node) bool {
+		attrs := attributes(n)
+		if id := attrs[`id`]; id != `` {
+			c.ids[id] = true
+		}
+		if n.TagName() == `label` && attrs[`for`] != `` {
+			c.labelled[attrs[`for`]] = true
+		}
+		return true
+	})
+}
+
+// scope describes the parent of the elements being checked.
+type scope struct {
+	selector string // the selector of the parent, or empty at the top of the page
+	labelled bool   // true inside a "label"
+	hidden   bool   // true inside an element that is hidden, where names are not needed
+}
+
+// children checks the elements in content, and their descendants.
+func (c *checker) children(content []html.Content, parent scope) {
+	counts := make(map[string]int)
+	for _, item := range content {
+		if n, ok := item.(html.Node); ok && n.TagName() != `` && n.TagName() != `#text` {
+			counts[n.TagName()]++
+		}
+	}
+	seen := make(map[string]int)
+	for _, item := range content {
+		n, ok := item.(html.Node)
+		if !ok {
+			continue
+		}
+		name := n.TagName()
+		switch name {
+		case `#text`:
+			continue
+		case ``:
+			c.children(n.Children(), parent) // a group, which tag.Parse does not produce for elements.
+			continue
+		}
+		seen[name]++
+		attrs := attributes(n)
+		inner := scope{
+			selector: segment(name, attrs, seen[name], counts[name]),
+			labelled: parent.labelled || name == `label`,
+			hidden:   parent.hidden || hidden(attrs),
+		}
+		if parent.selector != `` && attrs[`id`] == `` {
+			inner.selector = parent.selector + ` > ` + inner.selector
+		}
+		c.checkARIA(n, attrs, inner.selector)
+		if !inner.hidden {
+			c.element(n, attrs, inner.selector, parent.labelled)
+		}
+		c.children(n.Children(), inner)
+	}
+}
+
+// segment returns a selector for an element among its siblings, which is unique if it has an ID.
+func segment(name string, attrs map[string]string, nth, count int) string {
+	switch {
+	case attrs[`id`] != ``:
+		return name + `#` + escapeIdent(attrs[`id`])
+	case count > 1:
+		return name + `:nth-of-type(` + strconv.Itoa(nth) + `)`
+	}
+	return name
+}
+
+// escapeIdent escapes an ID for a selector, following the rules for CSS.escape in the CSSOM specification, so an ID
+// like "a/b" or "1st" becomes "a\/b" or "\31 st".
+func escapeIdent(id string) string {
+	var buf strings.Builder
+	for i, ch := range id {
+		switch {
+		case ch == 0:
+			buf.WriteRune(utf8.RuneError)
+		case ch < 0x20, ch == 0x7f,
+			'0' <= ch && ch <= '9' && (i == 0 || i == 1 && id[0] == '-'):
+			fmt.Fprintf(&buf, `\%x `, ch)
+		case ch == '-' && len(id) == 1:
+			buf.WriteString(`\-`)
+		case ch >= 0x80, ch == '-', ch == '_', '0' <= ch && ch <= '9', 'a' <= ch && ch <= 'z', 'A' <= ch && ch <= 'Z':
+			buf.WriteRune(ch)
+		default:
+			buf.WriteByte('\\')
+			buf.WriteRune(ch)
+		}
+	}
+	return buf.String()
+}
+
+func (c *checker) element(n html.Node, attrs map[string]string, selector string, labelled bool) {
+	name := n.TagName()
+	switch name {
+	case `img`, `area`:
+		if _, ok := attrs[`alt`]; !ok && !c.named(attrs) {
+			c.report(ImageAlt, selector, `<%v> has no alt attribute, use alt="" if it is decorative`, name)
+		}
+	case `input`:
+		switch typ := strings.ToLower(attrs[`type`]); typ {
+		case `hidden`:
+		case `image`:
+			if strings.TrimSpace(attrs[`alt`]) == `` && !c.named(attrs) {
+				c.report(ImageAlt, selector, `<input type="image"> has no alt text`)
+			}
+		case `submit`, `reset`:
+			// these have a default name, like "Submit", if they have no value.
+		case `button`:
+			if strings.TrimSpace(attrs[`value`]) == `` && !c.named(attrs) {
+				c.report(ButtonName, selector, `<input type="button"> has no value or accessible name`)
+			}
+		default:
+			c.checkLabel(name, attrs, selector, labelled)
+		}
+	case `select`, `textarea`:
+		c.checkLabel(name, attrs, selector, labelled)
+	case `button`:
+		if strings.TrimSpace(text(n)) == `` && !c.named(attrs) {
+			c.report(ButtonName, selector, `<button> has no text or accessible name`)
+		}
+	case `h1`, `h2`, `h3`, `h4`, `h5`, `h6`:
+		c.checkHeading(int(name[1]-'0'), selector)
+	default:
+		switch attrs[`role`] {
+		case `button`:
+			if strings.TrimSpace(text(n)) == `` && !c.named(attrs) {
+				c.report(ButtonName, selector, `<%v role="button"> has no text or accessible name`, name)
+			}
+		case `heading`:
+			if level, err := strconv.Atoi(attrs[`aria-level`]); err == nil {
+				c.checkHeading(level, selector)
+			}
+		}
+	}
+}
+
+// checkLabel reports a form control that is not inside a label, named by a label, or given an accessible name.
+func (c *checker) checkLabel(name string, attrs map[string]string, selector string, labelled bool) {
+	if labelled || attrs[`id`] != `` && c.labelled[attrs[`id`]] || c.named(attrs) {
+		return
+	}
+	if _, ok := attrs[`placeholder`]; ok {
+		c.report(Label, selector, `<%v> has a placeholder, but no label, which disappears once something is typed`, name)
+		return
+	}
+	c.report(Label, selector, `<%v> has no label or accessible name`, name)
+}
+
+func (c *checker) checkHeading(level int, selector string) {
+	if c.heading > 0 && level > c.heading+1 {
+		c.report(HeadingOrder, selector, `heading level %v follows level %v, skipping a level`, level, c.heading)
+	}
+	c.heading = level
+}
+
+// named returns true if the attributes give the element an accessible name with aria-label, aria-labelledby or title.
+func (c *checker) named(attrs map[string]string) bool {
+	if strings.TrimSpace(attrs[`aria-label`]) != `` || strings.TrimSpace(attrs[`title`]) != `` {
+		return true
+	}
+	for _, id := range strings.Fields(attrs[`aria-labelledby`]) {
+		if c.ids[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// checkARIA reports aria-* attributes that are not defined by WAI-ARIA, have invalid values, are deprecated, or refer
+// to IDs that are not in the page, as well as hiding an element that can be focused from screen readers.
+func (c *checker) checkARIA(n html.Node, attrs map[string]string, selector string) {
+	for attrName, value := range n.Attributes() {
+		if !strings.HasPrefix(attrName, `aria-`) {
+			continue
+		}
+		attr, ok := aria.Lookup(attrName)
+		switch {
+		case !ok:
+			c.report(ARIA, selector, `%v is not a WAI-ARIA attribute`, attrName)
+			continue
+		case !attr.Valid(value):
+			c.report(ARIA, selector, `%q is not a valid value for %v`, value, attrName)
+			continue
+		case attr.Deprecated:
+			c.report(ARIA, selector, `%v is deprecated`, attrName)
+		}
+		if attr.Type == aria.IDRef || attr.Type == aria.IDRefs {
+			for _, id := range strings.Fields(value) {
+				if !c.ids[id] {
+					c.report(ARIA, selector, `%v refers to %q, which is not an ID in the page`, attrName, id)
+				}
+			}
+		}
+	}
+	if attrs[`aria-hidden`] == `true` && focusable(n.TagName(), attrs) {
+		c.report(ARIA, selector, `<%v> can be focused, but is hidden from screen readers by aria-hidden`, n.TagName())
+	}
+}
+
+// focusable returns true if the element can be focused with the keyboard.
+func focusable(name string, attrs map[string]string) bool {
+	if _, ok := attrs[`disabled`]; ok {
+		return false
+	}
+	if index, ok := attrs[`tabindex`]; ok {
+		return !strings.HasPrefix(strings.TrimSpace(index), `-`)
+	}
+	switch name {
+	case `a`, `area`:
+		_, ok := attrs[`href`]
+		return ok
+	case `input`:
+		return !strings.EqualFold(attrs[`type`], `hidden`)
+	case `button`, `select`, `textarea`, `summary`, `iframe`:
+		return true
+	}
+	return false
+}
+
+// hidden returns true if the element is not presented to users, so it does not need a name.
+func hidden(attrs map[string]string) bool {
+	_, ok := attrs[`hidden`]
+	return ok || attrs[`aria-hidden`] == `true`
+}
+
+// text returns the text an element would contribute to its accessible name, including the alt text of images and
+// skipping hidden elements.
+func text(n html.Node) string {
+	var buf strings.Builder
+	appendText(&buf, n)
+	return buf.String()
+}
+
+func appendText(buf *strings.Builder, n html.Node) {
+	if text, ok := n.(html.Text); ok {
+		buf.WriteString(string(text))
+		return
+	}
+	attrs := attributes(n)
+	switch {
+	case hidden(attrs):
+		return
+	case strings.TrimSpace(attrs[`aria-label`]) != ``:
+		buf.WriteString(attrs[`aria-label`])
+		return
+	case n.TagName() == `img`:
+		buf.WriteString(attrs[`alt`])
+		return
+	}
+	for _, child := range n.Children() {
+		if child, ok := child.(html.Node); ok {
+			appendText(buf, child)
+		}
+	}
+}
+
+func attributes(n html.Node) map[string]string {
+	attrs := make(map[string]string)
+	for name, value := range n.Attributes() {
+		attrs[name] = value
+	}
+	return attrs
+}
@@ -0,0 +1,62 @@
+package a11y_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/swdunlop/html-go/a11y"
+	"github.com/swdunlop/html-go/tag"
+)
+
+func TestHTML(t *testing.T) {
+	test := func(name, src string, expect ...string) {
+		t.Helper()
+		findings := a11y.HTML([]byte(src))
+		if len(findings) != len(expect) {
+			t.Errorf("%v: expected %v findings, got %v: %v", name, len(expect), len(findings), findings)
+			return
+		}
+		for i, finding := range findings {
+			if result := finding.String(); result != expect[i] {
+				t.Errorf("%v: expected %q, got %q", name, expect[i], result)
+			}
+		}
+	}
+	test(`valid`, `<main><h1>Title</h1><h2>Part</h2><img src="a.png" alt=""><button>Go</button></main>`)
+	test(`img`, `<main><img src="a.png"><img src="b.png" alt="B"></main>`,
+		`image-alt: main > img:nth-of-type(1): <img> has no alt attribute, use alt="" if it is decorative`)
+	test(`input`, `<form id="login"><input name="user"><input name="pass" placeholder="Password"></form>`,
+		`label: form#login > input:nth-of-type(1): <input> has no label or accessible name`,
+		`label: form#login > input:nth-of-type(2): <input> has a placeholder, but no label, which disappears once `+
+			`something is typed`)
+	test(`labels`, `<label>User <input name="user"></label><label for="pass">Pass</label><input id="pass">`+
+		`<input aria-labelledby="note"><span id="note">Note</span><input type="hidden"><input type="submit">`)
+	test(`button`, `<div><button><svg></svg></button><button aria-label="Close">×</button>`+
+		`<button><img src="x.png" alt="Close"></button><span role="button"></span></div>`,
+		`button-name: div > button:nth-of-type(1): <button> has no text or accessible name`,
+		`button-name: div > span: <span role="button"> has no text or accessible name`)
+	test(`hidden`, `<div hidden><button></button></div><button aria-hidden="true" tabindex="-1"></button>`)
+	test(`heading`, `<h1>A</h1><h3>B</h3><h2>C</h2><h3>D</h3><h1>E</h1>`,
+		`heading-order: h3:nth-of-type(1): heading level 3 follows level 1, skipping a level`)
+	test(`aria`, `<div aria-hidden="maybe" aria-foo="1" aria-controls="menu"><a href="/" aria-hidden="true">A</a></div>`,
+		`aria: div: "maybe" is not a valid value for aria-hidden`,
+		`aria: div: aria-foo is not a WAI-ARIA attribute`,
+		`aria: div: aria-controls refers to "menu", which is not an ID in the page`,
+		`aria: div > a: <a> can be focused, but is hidden from screen readers by aria-hidden`)
+	test(`ids`, `<form id="a/b"><input></form><form id="1st"><input></form><form id="-"><input></form>`,
+		`label: form#a\/b > input: <input> has no label or accessible name`,
+		`label: form#\31 st > input: <input> has no label or accessible name`,
+		`label: form#\- > input: <input> has no label or accessible name`)
+}
+
+func TestCheck(t *testing.T) {
+	form := tag.New(`form#search`).Add(tag.New(`input[name=q]`), tag.New(`button`))
+	err := a11y.Check(form)
+	if err == nil || !strings.Contains(err.Error(), `label: form#search > input`) ||
+		!strings.Contains(err.Error(), `button-name: form#search > button`) {
+		t.Errorf("expected label and button-name findings, got %v", err)
+	}
+	if err := a11y.Check(form, a11y.Ignore(a11y.Label, a11y.ButtonName)); err != nil {
+		t.Errorf("expected no error when ignoring the rules, got %v", err)
+	}
+}
@@ -22,6 +22,17 @@ func RenderPage(r *http.Request, page func(PartMap) html.Content, parts ...Part)
 	return page(table)
 }
 
+// RenderFragment returns the page if the X-Alpine-Target header is not present, otherwise, it uses html.Fragment to
+// render only the requested elements of the page, in the order they appear in the page, so a page does not need to be
+// split into parts to answer Alpine AJAX requests.
+func RenderFragment(r *http.Request, page html.Content) html.Content {
+	targets := strings.Fields(r.Header.Get(`X-Alpine-Target`))
+	if len(targets) == 0 {
+		return page
+	}
+	return html.Fragment(page, targets...)
+}
+
 // Render parses the X-Alpine-Target header and returns a html.Group containing only the requested parts, in the order
 // they were specified as arguments to render.  (This is specified so the last part can be an "errors" part that lists
 // any errors that occurred while rendering the other parts.)
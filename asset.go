@@ -0,0 +1,121 @@
+package html
+
+import (
+	"context"
+
+	"github.com/swdunlop/html-go/internal/rawtext"
+)
+
+// An Asset is content that belongs in the head of a document, like a stylesheet or a script, and is only needed once
+// no matter how many times the content that requires it is rendered.  InlineStyle and InlineScript are assets for CSS
+// and JavaScript in the page, and the page package provides assets for linked stylesheets and scripts.
+type Asset interface {
+	Content
+
+	// AssetKey identifies the asset, like the URL of a script, so an asset is only added once.
+	AssetKey() string
+}
+
+// InlineStyle is trusted CSS for a "style" tag in the head of a document, like the CSS a widget needs, which is
+// identified by its text.
+type InlineStyle string
+
+// AssetKey implements Asset, identifying the style by its text.
+func (css InlineStyle) AssetKey() string { return `<style>` + string(css) }
+
+// AppendHTML implements Content by appending a "style" tag with the CSS, escaped like the content of a style tag from
+// the tag package.
+func (css InlineStyle) AppendHTML(buf []byte) []byte {
+	buf = append(buf, `<style>`...)
+	buf = rawtext.Append(buf, `style`, string(css))
+	return append(buf, `</style>`...)
+}
+
+// InlineScript is trusted JavaScript for a "script" tag in the head of a document, which is identified by its text.
+type InlineScript string
+
+// AssetKey implements Asset, identifying the script by its text.
+func (js InlineScript) AssetKey() string { return `<script>` + string(js) }
+
+// AppendHTML implements Content by appending a "script" tag with the JavaScript, escaped like the content of a script
+// tag from the tag package.
+func (js InlineScript) AppendHTML(buf []byte) []byte {
+	buf = append(buf, `<script>`...)
+	buf = rawtext.Append(buf, `script`, string(js))
+	return append(buf, `</script>`...)
+}
+
+// Require returns content that declares the assets needed by the content around it, like the script for a widget.
+// When rendered with a context from CollectAssets, like the body of a page.Document, the assets are collected for the
+// head of the document instead, so including a widget twice only adds its script once.  Otherwise, the assets are
+// rendered where they are required.  Nothing is rendered in a Fragment, since the page it updates already has them.
+func Require(assets ...Asset) Content { return required(assets) }
+
+type required []Asset
+
+// AppendHTML implements Content by rendering the assets in place.
+func (assets required) AppendHTML(buf []byte) []byte {
+	return assets.AppendHTMLContext(context.Background(), buf)
+}
+
+// AppendHTMLContext implements ContextContent by collecting the assets, or rendering them in place if ctx does not
+// collect assets.
+func (assets required) AppendHTMLContext(ctx context.Context, buf []byte) []byte {
+	if _, ok := ctx.Value(fragmentKey{}).(*fragmentSearch); ok {
+		return buf
+	}
+	collection := assetsOf(ctx)
+	for _, asset := range assets {
+		if collection == nil || collection.add(asset) && collection.closed {
+			buf = AppendContext(ctx, buf, asset)
+		}
+	}
+	return buf
+}
+
+// CollectAssets returns a context that collects the assets required by content rendered with it, in the order they
+// are first required, and the collection of assets.
+func CollectAssets(ctx context.Context) (context.Context, *Assets) {
+	assets := &Assets{keys: make(map[string]bool)}
+	return context.WithValue(ctx, assetsKey{}, assets), assets
+}
+
+// Assets collects the assets required while content is rendered, for a document to add to its head.
+type Assets struct {
+	keys   map[string]bool
+	list   []Asset
+	closed bool
+}
+
+// Add adds assets to the collection, skipping any with the key of an asset that was already added, which lets a
+// document add the assets it always needs before its content is rendered.
+func (assets *Assets) Add(list ...Asset) {
+	for _, asset := range list {
+		assets.add(asset)
+	}
+}
+
+func (assets *Assets) add(asset Asset) bool {
+	key := asset.AssetKey()
+	if assets.keys[key] {
+		return false
+	}
+	assets.keys[key] = true
+	assets.list = append(assets.list, asset)
+	return true
+}
+
+// Close returns the assets that were collected, in order.  Since the head of the document has been rendered once the
+// collection is closed, any asset that is required for the first time afterward is rendered where it is required,
+// still only once.
+func (assets *Assets) Close() []Asset {
+	assets.closed = true
+	return assets.list
+}
+
+type assetsKey struct{}
+
+func assetsOf(ctx context.Context) *Assets {
+	assets, _ := ctx.Value(assetsKey{}).(*Assets)
+	return assets
+}
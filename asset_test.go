@@ -0,0 +1,68 @@
+package html_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+)
+
+type testAsset string
+
+func (a testAsset) AssetKey() string { return string(a) }
+func (a testAsset) AppendHTML(buf []byte) []byte {
+	return append(buf, `<script src='`+a+`'></script>`...)
+}
+
+func TestRequire(t *testing.T) {
+	widget := tag.New(`div.widget`).Add(html.Require(testAsset(`a.js`), testAsset(`b.js`)))
+	content := html.Group{widget, widget, tag.New(`p`).Add(html.Require(testAsset(`a.js`)))}
+
+	inline := string(html.Append(nil, content))
+	if expect := `<div class='widget'><script src='a.js'></script><script src='b.js'></script></div>` +
+		`<div class='widget'><script src='a.js'></script><script src='b.js'></script></div>` +
+		`<p><script src='a.js'></script></p>`; inline != expect {
+		t.Errorf("expected assets to be rendered in place without a collection, got %q", inline)
+	}
+
+	ctx, assets := html.CollectAssets(context.Background())
+	assets.Add(testAsset(`b.js`))
+	collected := string(html.AppendContext(ctx, nil, content))
+	if expect := `<div class='widget'></div><div class='widget'></div><p></p>`; collected != expect {
+		t.Errorf("expected %q, got %q", expect, collected)
+	}
+	list := assets.Close()
+	if len(list) != 2 || list[0] != testAsset(`b.js`) || list[1] != testAsset(`a.js`) {
+		t.Errorf("expected b.js then a.js to be collected, got %v", list)
+	}
+
+	late := string(html.AppendContext(ctx, nil, content, html.Require(testAsset(`c.js`))))
+	if expect := `<div class='widget'></div><div class='widget'></div><p></p><script src='c.js'></script>`; late != expect {
+		t.Errorf("expected only new assets to be rendered in place after closing, got %q", late)
+	}
+
+	fragment := string(html.Append(nil, html.Fragment(tag.New(`div#w`).Add(html.Require(testAsset(`d.js`))), `w`)))
+	if expect := `<div id='w'></div>`; fragment != expect {
+		t.Errorf("expected assets to be skipped in a fragment, got %q", fragment)
+	}
+}
+
+func TestInlineAssets(t *testing.T) {
+	content := html.Group{
+		html.InlineStyle(`p{color:red}`),
+		html.InlineScript(`x</script><img onerror=1>`),
+		html.Require(html.InlineStyle(`p{color:red}`), html.InlineStyle(`</style>`)),
+	}
+	ctx, assets := html.CollectAssets(context.Background())
+	assets.Add(html.InlineStyle(`p{color:red}`))
+	result := string(html.AppendContext(ctx, nil, content))
+	expect := `<style>p{color:red}</style><script>x<\/script><img onerror=1></script>`
+	if result != expect {
+		t.Errorf("expected %q, got %q", expect, result)
+	}
+	list := assets.Close()
+	if len(list) != 2 || string(html.Append(nil, list[1])) != `<style><\/style></style>` {
+		t.Errorf("expected the repeated style to be skipped and the new one escaped, got %v", list)
+	}
+}
@@ -0,0 +1,334 @@
+package html
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// URL is a URL that is trusted to be safe in a URL attribute, like href or src, even if its scheme could run script.
+// Use SafeURL to convert an untrusted URL into a URL.  As content, a URL is escaped like Text.
+type URL string
+
+// AppendHTML implements Content by appending the URL as escaped text.
+func (url URL) AppendHTML(buf []byte) []byte { return AppendText(buf, string(url)) }
+
+// JS is JavaScript that is trusted to be safe in an event handler attribute, like onclick, or as the content of a
+// script tag.  Use JSString or JSValue to convert untrusted values into JavaScript literals.
+type JS string
+
+// AppendHTML implements Content by appending the JavaScript as escaped text, like Text.  The tag package appends it
+// verbatim inside a script tag, which is the only place it is meaningful as content.
+func (js JS) AppendHTML(buf []byte) []byte { return AppendText(buf, string(js)) }
+
+// CSS is CSS that is trusted to be safe in a style attribute or as the content of a style tag.  Use SafeCSS to
+// convert an untrusted value into CSS.
+type CSS string
+
+// AppendHTML implements Content by appending the CSS as escaped text, like Text.  The tag package appends it verbatim
+// inside a style tag, which is the only place it is meaningful as content.
+func (css CSS) AppendHTML(buf []byte) []byte { return AppendText(buf, string(css)) }
+
+// Attr is a trusted fragment of a start tag containing one or more attributes, like `dir='ltr'`.  Adding an Attr to a
+// tag using the tag package adds the attributes to the tag instead of its content.  Use Attribute to build an Attr
+// from an attribute name and untrusted values.
+type Attr string
+
+// AppendHTML implements Content by appending the attributes verbatim, which is only meaningful inside a start tag.
+func (attr Attr) AppendHTML(buf []byte) []byte { return append(buf, attr...) }
+
+const (
+	// unsafeURL replaces URLs that are not safe to use in a URL attribute; this is the same value used by html/template.
+	unsafeURL = `#ZgotmplZ`
+
+	// unsafeCSS replaces CSS values that are not safe to use in a style attribute.
+	unsafeCSS = `ZgotmplZ`
+)
+
+// SafeURL returns the URL if it is relative or its scheme is http, https, mailto or tel.  Otherwise, it returns
+// "#ZgotmplZ", like html/template, so a "javascript:" URL cannot run script.
+func SafeURL(url string) URL {
+	if safeURL(url) {
+		return URL(url)
+	}
+	return unsafeURL
+}
+
+// SafeCSS returns the value if it cannot escape a CSS property value, using the same rules as html/template.
+// Otherwise, it returns "ZgotmplZ".  This rejects quotes, semicolons, parentheses and comments, among other things.
+func SafeCSS(value string) CSS {
+	if safeCSS(value) {
+		return CSS(value)
+	}
+	return unsafeCSS
+}
+
+// JSString quotes text as a JavaScript string literal, escaping anything that could end the string, the script or
+// an attribute it is embedded in.
+func JSString(text string) JS { return JS(jsString(text)) }
+
+// JSValue encodes a value as a JavaScript literal.  Strings, fmt.Stringers and errors become string literals, other
+// values are encoded as JSON.  This will panic if the value cannot be encoded as JSON.
+func JSValue(value any) JS { return JS(jsValue(value)) }
+
+// Attribute builds an attribute fragment from an attribute name and its values, sanitizing the values in the same way
+// as AppendAttrValue.  This will panic if the name is not a valid attribute name.
+func Attribute(name string, values ...any) Attr {
+	if !ValidAttrName(name) {
+		panic(fmt.Errorf(`%q is not a valid attribute name`, name))
+	}
+	if len(values) == 0 {
+		return Attr(name)
+	}
+	buf := make([]byte, 0, len(name)+64)
+	buf = append(buf, name...)
+	buf = append(buf, '=', '\'')
+	buf = AppendAttrValue(buf, name, values...)
+	buf = append(buf, '\'')
+	return Attr(buf)
+}
+
+// ValidAttrName returns true if the name can be used as an attribute name -- it must not be empty and may not contain
+// whitespace, control characters, quotes, "=", "<", ">" or "/".
+func ValidAttrName(name string) bool {
+	if name == `` {
+		return false
+	}
+	for _, ch := range name {
+		switch {
+		case ch <= ' ', ch == 0x7f, ch == '"', ch == '\'', ch == '=', ch == '<', ch == '>', ch == '/', ch == '`':
+			return false
+		}
+	}
+	return true
+}
+
+// AppendAttrValue appends the values of the named attribute to buf, escaped for use in a single quoted attribute
+// value.  Like html/template, values are sanitized depending on how the browser will use the attribute:
+//
+//   - URL attributes, like href and src, are replaced with "#ZgotmplZ" if the URL has a scheme other than http,
+//     https, mailto or tel; see SafeURL.
+//   - Event handler attributes, like onclick, are JavaScript, so each value is encoded as a JavaScript literal; see
+//     JSValue.
+//   - Values for the style attribute that could escape a CSS property value are replaced with "ZgotmplZ"; see
+//     SafeCSS.
+//
+// Values of type URL, JS and CSS are trusted in their respective attributes and are not sanitized, and HTML is
+// trusted in any attribute.
+func AppendAttrValue(buf []byte, name string, values ...any) []byte {
+	return AppendAttrText(buf, AttrValue(name, values...))
+}
+
+// AttrValue returns the values of the named attribute, sanitized like AppendAttrValue but not escaped.
+func AttrValue(name string, values ...any) string {
+	var raw strings.Builder
+	switch context := contextOf(name); context {
+	case jsContext:
+		for _, value := range values {
+			if text, ok := trusted(value, context); ok {
+				raw.WriteString(text)
+			} else {
+				raw.WriteString(jsValue(value))
+			}
+		}
+	case cssContext:
+		for _, value := range values {
+			if text, ok := trusted(value, context); ok {
+				raw.WriteString(text)
+			} else if text = fmt.Sprint(value); safeCSS(text) {
+				raw.WriteString(text)
+			} else {
+				raw.WriteString(unsafeCSS)
+			}
+		}
+	case urlContext, srcsetContext:
+		safe := true
+		for _, value := range values {
+			text, ok := trusted(value, context)
+			if !ok {
+				text = fmt.Sprint(value)
+				safe = false
+			}
+			raw.WriteString(text)
+		}
+		if !safe {
+			if context == urlContext && !safeURL(raw.String()) {
+				return unsafeURL
+			} else if context == srcsetContext && !safeSrcset(raw.String()) {
+				return unsafeURL
+			}
+		}
+	default:
+		for _, value := range values {
+			if text, ok := trusted(value, context); ok {
+				raw.WriteString(text)
+			} else {
+				raw.WriteString(fmt.Sprint(value))
+			}
+		}
+	}
+	return raw.String()
+}
+
+// AppendAttrText appends text escaped for use in a single quoted attribute value, escaping "'" and "&" using
+// entities.  Unlike AppendAttrValue, the text is not sanitized.
+func AppendAttrText(buf []byte, text string) []byte {
+	for _, ch := range []byte(text) {
+		switch ch {
+		case '\'':
+			buf = append(buf, `&apos;`...)
+		case '&':
+			buf = append(buf, `&amp;`...)
+		default:
+			buf = append(buf, ch)
+		}
+	}
+	return buf
+}
+
+type attrContext int
+
+const (
+	plainContext attrContext = iota
+	urlContext
+	srcsetContext
+	jsContext
+	cssContext
+)
+
+// contextOf determines how values for an attribute should be sanitized, following the lead of html/template.
+func contextOf(name string) attrContext {
+	name = strings.ToLower(name)
+	if strings.HasPrefix(name, `on`) {
+		return jsContext
+	}
+	switch name {
+	case `style`:
+		return cssContext
+	case `srcset`, `imagesrcset`:
+		return srcsetContext
+	case `href`, `src`, `action`, `formaction`, `cite`, `poster`, `background`, `longdesc`, `manifest`, `icon`,
+		`codebase`, `data`, `usemap`, `ping`, `xlink:href`:
+		return urlContext
+	}
+	return plainContext
+}
+
+// trusted returns the text of a value that does not need sanitization in the provided context.
+func trusted(value any, context attrContext) (string, bool) {
+	switch value := value.(type) {
+	case HTML:
+		return string(value), true
+	case URL:
+		return string(value), context == urlContext || context == srcsetContext
+	case JS:
+		return string(value), context == jsContext
+	case CSS:
+		return string(value), context == cssContext
+	}
+	return ``, false
+}
+
+// safeURL returns true if the URL is relative or uses a scheme that cannot run script.
+func safeURL(url string) bool {
+	// Browsers ignore leading whitespace and control characters, and strip tabs and newlines anywhere in a URL, so
+	// "java\tscript:" is still a javascript URL.
+	url = strings.Map(func(ch rune) rune {
+		switch ch {
+		case '\t', '\n', '\r':
+			return -1
+		}
+		return ch
+	}, url)
+	url = strings.TrimLeft(url, "\x00\x01\x02\x03\x04\x05\x06\x07\x08\x0b\x0c\x0e\x0f"+
+		"\x10\x11\x12\x13\x14\x15\x16\x17\x18\x19\x1a\x1b\x1c\x1d\x1e\x1f ")
+	end := strings.IndexAny(url, `:/?#`)
+	if end < 0 || url[end] != ':' {
+		return true // no scheme, so the URL is relative.
+	}
+	switch strings.ToLower(url[:end]) {
+	case `http`, `https`, `mailto`, `tel`:
+		return true
+	}
+	return false
+}
+
+// safeSrcset returns true if every image candidate in a srcset has a safe URL.
+func safeSrcset(srcset string) bool {
+	for candidate := range strings.SplitSeq(srcset, `,`) {
+		fields := strings.Fields(candidate)
+		if len(fields) > 0 && !safeURL(fields[0]) {
+			return false
+		}
+	}
+	return true
+}
+
+// safeCSS returns true if the value cannot escape a CSS property value, using the same rules as html/template.
+func safeCSS(value string) bool {
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case 0, '"', '\'', '(', ')', '/', ';', '@', '[', '\\', ']', '`', '{', '}', '<', '>':
+			return false
+		case '-':
+			if i > 0 && value[i-1] == '-' {
+				return false
+			}
+		}
+	}
+	lower := strings.ToLower(value)
+	return !strings.Contains(lower, `expression`) && !strings.Contains(lower, `mozbinding`)
+}
+
+// jsValue encodes a value as a JavaScript literal that is safe to embed in HTML.
+func jsValue(value any) string {
+	switch value := value.(type) {
+	case string:
+		return jsString(value)
+	case fmt.Stringer:
+		return jsString(value.String())
+	case error:
+		return jsString(value.Error())
+	}
+	js, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	// json.Marshal escapes <, > and & by default, and AppendAttrText takes care of quotes in attributes.
+	return string(js)
+}
+
+// jsString quotes a string as a JavaScript string literal, escaping anything that could end the string, the script
+// or the attribute it is embedded in.
+func jsString(text string) string {
+	var buf strings.Builder
+	buf.Grow(len(text) + 2)
+	buf.WriteByte('"')
+	for i := 0; i < len(text); {
+		ch, n := utf8.DecodeRuneInString(text[i:])
+		i += n
+		switch ch {
+		case '\\':
+			buf.WriteString(`\\`)
+		case '"':
+			buf.WriteString(`\"`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		case '<', '>', '&', '\'', '`', '\u2028', '\u2029':
+			fmt.Fprintf(&buf, `\u%04x`, ch)
+		default:
+			if ch < 0x20 {
+				fmt.Fprintf(&buf, `\u%04x`, ch)
+			} else {
+				buf.WriteRune(ch)
+			}
+		}
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
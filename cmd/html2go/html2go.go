@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/internal/selector"
+	"github.com/swdunlop/html-go/internal/whatwg"
+	"github.com/swdunlop/html-go/tag"
+)
+
+var opt struct {
+	Package string
+	Var     string
+	Output  string
+	Static  bool
+}
+
+func main() {
+	flag.Usage = usage
+	flag.StringVar(&opt.Package, `package`, `main`, `package name for the generated source`)
+	flag.StringVar(&opt.Var, `var`, `content`, `variable name for the generated content`)
+	flag.StringVar(&opt.Output, `o`, ``, `write the generated source to a file instead of stdout`)
+	flag.BoolVar(&opt.Static, `static`, true, `use html.Static for subtrees without IDs`)
+	flag.Parse()
+
+	var err error
+	switch flag.NArg() {
+	case 0:
+		err = run(os.Stdin, `stdin`)
+	case 1:
+		err = runFile(flag.Arg(0))
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "!! %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	os.Stderr.WriteString(`USAGE: html2go [-package name] [-var name] [-o file] [-static=false] [<file>]
+FLAGS:
+  -package  Package name for the generated source, default "main"
+  -var      Variable name for the generated content, default "content"
+  -o        Write the generated source to a file instead of stdout
+  -static   Use html.Static for subtrees without IDs, default true
+
+This utility reads an HTML document or snippet, from a file or stdin, and writes Go source that builds the same
+content using tag.New, so mockups can be ported to Go and then changed by hand.  Subtrees that have no IDs are
+wrapped in html.Static, so they are only rendered once; add an ID to anything that will need dynamic content.
+
+  html2go -package views -var loginForm login.html > login.go
+`)
+}
+
+func runFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return run(f, path)
+}
+
+func run(r io.Reader, source string) error {
+	src, err := generate(r, source)
+	if err != nil {
+		return err
+	}
+	if opt.Output == `` {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(opt.Output, src, 0o644)
+}
+
+// generate converts HTML into formatted Go source, using the package and variable names from opt.
+func generate(r io.Reader, source string) ([]byte, error) {
+	group, err := tag.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	var g generator
+	items := children(group, false)
+	fmt.Fprintf(&g.body, "var %v = ", opt.Var)
+	switch len(items) {
+	case 0:
+		g.useHTML = true
+		g.body.WriteString(`html.Group{}`)
+	case 1:
+		g.content(items[0], false)
+	default:
+		g.useHTML = true
+		g.body.WriteString("html.Group{\n")
+		for _, item := range items {
+			g.content(item, false)
+			g.body.WriteString(",\n")
+		}
+		g.body.WriteString(`}`)
+	}
+	g.body.WriteString("\n")
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Converted from %v by html2go.\n\npackage %v\n\nimport (\n", source, opt.Package)
+	if g.useHTML {
+		buf.WriteString("\t\"github.com/swdunlop/html-go\"\n")
+	}
+	if g.useTag {
+		buf.WriteString("\t\"github.com/swdunlop/html-go/tag\"\n")
+	}
+	buf.WriteString(")\n\n")
+	buf.Write(g.body.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+type generator struct {
+	body    bytes.Buffer
+	useHTML bool // true if the html package is used
+	useTag  bool // true if the tag package is used
+}
+
+// content writes a Go expression for the content; pre is true inside elements where whitespace matters.
+func (g *generator) content(content html.Content, pre bool) {
+	switch content := content.(type) {
+	case html.Text:
+		g.useHTML = true
+		fmt.Fprintf(&g.body, `html.Text(%v)`, quote(string(content)))
+	case tag.Interface:
+		if opt.Static && static(content) && hasElements(content) {
+			g.useHTML = true
+			g.body.WriteString(`html.Static(`)
+			g.element(content, pre, true)
+			g.body.WriteString(`)`)
+		} else {
+			g.element(content, pre, false)
+		}
+	default:
+		g.useHTML = true
+		fmt.Fprintf(&g.body, `html.HTML(%v)`, quote(string(content.AppendHTML(nil))))
+	}
+}
+
+// element writes a tag.New expression for the element; inStatic is true if it is already wrapped by html.Static.
+func (g *generator) element(t tag.Interface, pre, inStatic bool) {
+	name := t.TagName()
+	if !selector.ValidName(name) {
+		// the selector parser cannot handle names like "my:tag", but the markup can still be kept as HTML.
+		g.useHTML = true
+		fmt.Fprintf(&g.body, `html.HTML(%v)`, quote(string(t.AppendHTML(nil))))
+		return
+	}
+	g.useTag = true
+	sel, sets := selectorOf(t)
+	fmt.Fprintf(&g.body, `tag.New(%v)`, quote(sel))
+	for _, set := range sets {
+		fmt.Fprintf(&g.body, `.Set(%v)`, quote(set))
+	}
+
+	elem, _ := whatwg.Lookup(name)
+	pre = pre || name == `pre` || elem != nil && (elem.RawText || elem.RCDATA)
+	items := children(t.Children(), pre)
+	switch {
+	case len(items) == 0:
+	case len(items) == 1 && isText(items[0]):
+		fmt.Fprintf(&g.body, `.Text(%v)`, quote(string(items[0].(html.Text))))
+	default:
+		g.body.WriteString(".Add(\n")
+		for _, item := range items {
+			if child, ok := item.(tag.Interface); ok && inStatic {
+				g.element(child, pre, true)
+			} else {
+				g.content(item, pre)
+			}
+			g.body.WriteString(",\n")
+		}
+		g.body.WriteString(`)`)
+	}
+}
+
+// children returns the content that should be generated, collapsing whitespace in text unless pre is true and
+// dropping line breaks and indentation, unless they separate inline content, where they would be rendered as a space.
+func children(content []html.Content, pre bool) []html.Content {
+	if pre {
+		return content
+	}
+	items := make([]html.Content, 0, len(content))
+	for i, item := range content {
+		text, ok := item.(html.Text)
+		if !ok {
+			items = append(items, item)
+			continue
+		}
+		if strings.TrimSpace(string(text)) == `` && strings.ContainsAny(string(text), "\r\n") &&
+			!(inline(content, i-1) && inline(content, i+1)) {
+			continue
+		}
+		items = append(items, html.Text(collapse(string(text))))
+	}
+	return items
+}
+
+// inline returns true if the content at index i is text or an inline element, where whitespace is significant.
+func inline(content []html.Content, i int) bool {
+	if i < 0 || i >= len(content) {
+		return false
+	}
+	switch item := content[i].(type) {
+	case html.Text:
+		return true
+	case tag.Interface:
+		elem, ok := whatwg.Lookup(item.TagName())
+		return ok && elem.Inline
+	}
+	return false // comments and doctypes
+}
+
+// collapse replaces each run of whitespace that includes a line break with a single space.
+func collapse(text string) string {
+	var buf strings.Builder
+	for i := 0; i < len(text); {
+		j := i
+		for j < len(text) && isSpace(text[j]) {
+			j++
+		}
+		switch {
+		case j == i:
+			buf.WriteByte(text[i])
+			i++
+			continue
+		case strings.ContainsAny(text[i:j], "\r\n"):
+			buf.WriteByte(' ')
+		default:
+			buf.WriteString(text[i:j])
+		}
+		i = j
+	}
+	return buf.String()
+}
+
+// selectorOf returns a selector for the tag and its attributes, and a list of attributes that are too long or
+// unwieldy for a selector, formatted for Set as "name=value".
+func selectorOf(t tag.Interface) (string, []string) {
+	var sel strings.Builder
+	var sets []string
+	sel.WriteString(t.TagName())
+	for name, value := range t.Attributes() {
+		switch {
+		case name == `id` && validIdent(value):
+			sel.WriteString(`#` + value)
+		case name == `id` && strings.ContainsAny(value, " \t\r\n\f"):
+			sets = append(sets, name+`=`+value)
+		case name == `class` && validClasses(value):
+			for _, class := range strings.Fields(value) {
+				sel.WriteString(`.` + class)
+			}
+		case strings.ContainsAny(name, `[]`) || len(value) > 40 || strings.ContainsAny(value, "\r\n\f"):
+			sets = append(sets, name+`=`+value)
+		case value == ``:
+			sel.WriteString(`[` + name + `]`)
+		case strings.ContainsAny(value, " \t\"'\\]"):
+			sel.WriteString(`[` + name + `="` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"]`)
+		default:
+			sel.WriteString(`[` + name + `=` + value + `]`)
+		}
+	}
+	return sel.String(), sets
+}
+
+// static returns true if neither the tag nor its descendants have an ID, which suggests they do not need to change.
+func static(t tag.Interface) bool {
+	if t.ID() != `` {
+		return false
+	}
+	for _, child := range t.Children() {
+		if child, ok := child.(tag.Interface); ok && !static(child) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasElements returns true if the tag contains other tags, since wrapping a single tag in html.Static gains little.
+func hasElements(t tag.Interface) bool {
+	for _, child := range t.Children() {
+		if _, ok := child.(tag.Interface); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func isText(content html.Content) bool {
+	_, ok := content.(html.Text)
+	return ok
+}
+
+// validIdent returns true if the text can follow "#" or "." in a selector.
+func validIdent(text string) bool { return text != `` && !strings.ContainsAny(text, "#.[]\\ \t\r\n\f") }
+
+func validClasses(value string) bool {
+	fields := strings.Fields(value)
+	for _, class := range fields {
+		if !validIdent(class) {
+			return false
+		}
+	}
+	return len(fields) > 0
+}
+
+func isSpace(ch byte) bool { return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' || ch == '\f' }
+
+// quote returns a Go string literal for the text, preferring raw strings since HTML is full of double quotes.
+func quote(text string) string {
+	if strings.ContainsAny(text, "`\r") || !strconv.CanBackquote(strings.ReplaceAll(text, "\n", ``)) {
+		return strconv.Quote(text)
+	}
+	return "`" + text + "`"
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	opt.Package, opt.Var, opt.Static = `views`, `form`, true
+	src, err := generate(strings.NewReader(`
+<form id="login" action="/login">
+  <input type="text" name="username" placeholder="Your Name" required>
+  <p class="hint">Forgot your <b>password</b>?</p>
+</form>
+`), `login.html`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "// Converted from login.html by html2go.\n\npackage views\n\nimport (\n" +
+		"\t\"github.com/swdunlop/html-go\"\n\t\"github.com/swdunlop/html-go/tag\"\n)\n\n" +
+		"var form = tag.New(`form#login[action=/login]`).Add(\n" +
+		"\ttag.New(`input[type=text][name=username][placeholder=\"Your Name\"][required]`),\n" +
+		"\thtml.Static(tag.New(`p.hint`).Add(\n" +
+		"\t\thtml.Text(`Forgot your `),\n" +
+		"\t\ttag.New(`b`).Text(`password`),\n" +
+		"\t\thtml.Text(`?`),\n" +
+		"\t)),\n" +
+		")\n"
+	if string(src) != expect {
+		t.Errorf("expected:\n%v\ngot:\n%v", expect, string(src))
+	}
+}
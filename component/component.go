@@ -0,0 +1,168 @@
+// Package component defines reusable pieces of a UI kit, like cards, modals and navbars, with typed props and named
+// slots, so every component in a codebase is built and used the same way:
+//
+//	type CardProps struct{ Title string }
+//
+//	var Card = component.Define(`card`, func(props CardProps, slots component.Slots) tag.Interface {
+//		return tag.New(`article.card`).Add(
+//			tag.New(`header`).Text(props.Title),
+//			slots.Content(),
+//			tag.New(`footer`).Add(slots.Get(`footer`)),
+//		)
+//	}, `footer`).Default(`footer`, html.Text(`No actions`))
+//
+//	Card.New(CardProps{Title: `Users`},
+//		component.Class(`wide`),
+//		component.Slot(`footer`, tag.New(`button`).Text(`Save`)),
+//		userTable,
+//	)
+//
+// A component renders to an ordinary tag, so the result can be changed with the methods of tag.Interface, found by
+// the query package and targeted by html.Fragment like any other tag.
+package component
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+)
+
+// A Definition describes a component with props of type P.  Since each method returns a copy, a definition is
+// usually kept in a package variable and shared.
+type Definition[P any] struct {
+	name     string
+	render   func(props P, slots Slots) tag.Interface
+	slots    []string
+	defaults map[string]html.Group
+}
+
+// Define returns the definition of a component with named slots, which is rendered by calling render with the props
+// and slots passed to New.  The name is used in panics that describe misuse of the component.
+func Define[P any](name string, render func(props P, slots Slots) tag.Interface, slots ...string) Definition[P] {
+	return Definition[P]{name: name, render: render, slots: slices.Clone(slots)}
+}
+
+// Name returns the name of the component.
+func (def Definition[P]) Name() string { return def.name }
+
+// Default returns a copy of the definition with default content for a slot, which is used when New is not given any
+// content for the slot, declaring the slot if Define did not.  The unnamed slot, "", holds the content that is not in
+// a named slot.
+func (def Definition[P]) Default(slot string, content ...html.Content) Definition[P] {
+	if slot != `` && !slices.Contains(def.slots, slot) {
+		def.slots = append(slices.Clip(def.slots), slot)
+	}
+	def.defaults = maps.Clone(def.defaults)
+	if def.defaults == nil {
+		def.defaults = make(map[string]html.Group)
+	}
+	def.defaults[slot] = html.Group(content)
+	return def
+}
+
+// New renders the component with the props and content, returning its root tag.  Content added with Slot goes in
+// the named slot, content from Class and Attr is added to the root tag, and other content goes in the unnamed slot,
+// which the component places with Slots.Content.  Content in an html.Group is treated as if it was passed to New
+// directly.
+//
+// New panics if content is given for a named slot that was not declared by Define or Default, which is usually a typo.
+func (def Definition[P]) New(props P, content ...html.Content) tag.Interface {
+	slots := Slots{
+		content:  make(map[string]html.Group),
+		defaults: def.defaults,
+	}
+	var attrs []attr
+	var add func(item html.Content)
+	add = func(item html.Content) {
+		switch item := item.(type) {
+		case html.Group: // flattened, so slots and attributes in a group are not lost in the unnamed slot.
+			for _, inner := range item {
+				add(inner)
+			}
+		case slot:
+			if item.name != `` && !slices.Contains(def.slots, item.name) {
+				panic(fmt.Errorf(`component %v does not have a %q slot`, def.name, item.name))
+			}
+			slots.content[item.name] = append(slots.content[item.name], item.content...)
+		case attr:
+			attrs = append(attrs, item)
+		default:
+			slots.content[``] = append(slots.content[``], item)
+		}
+	}
+	for _, item := range content {
+		add(item)
+	}
+	root := def.render(props, slots)
+	for _, attr := range attrs {
+		if attr.classes != nil {
+			root = root.Class(attr.classes...)
+		} else {
+			root = root.Set(attr.name, attr.values...)
+		}
+	}
+	return root
+}
+
+// Slots holds the content for each slot of a component while it is rendered.
+type Slots struct {
+	content  map[string]html.Group
+	defaults map[string]html.Group
+}
+
+// Get returns the content for the named slot, its default content if it was not given any, or an empty group if
+// it has no default either.  Like Has, a slot that was only given an empty Slot is treated as if it was not given any.
+func (slots Slots) Get(name string) html.Content {
+	if content := slots.content[name]; len(content) > 0 {
+		return content
+	}
+	if content, ok := slots.defaults[name]; ok {
+		return content
+	}
+	return html.Group{}
+}
+
+// Has returns true if the named slot was given content or has default content, which lets a component leave out
+// the markup around an empty slot, like the footer of a card.
+func (slots Slots) Has(name string) bool {
+	return len(slots.content[name]) > 0 || len(slots.defaults[name]) > 0
+}
+
+// Content returns the content for the unnamed slot, which is the content passed to New that is not in a named slot.
+func (slots Slots) Content() html.Content { return slots.Get(``) }
+
+// Slot returns content for the named slot of a component, which must be passed to Definition.New.
+func Slot(name string, content ...html.Content) html.Content {
+	return slot{name: name, content: content}
+}
+
+type slot struct {
+	name    string
+	content html.Group
+}
+
+// AppendHTML implements html.Content by appending nothing, since a slot only has meaning to Definition.New.
+func (slot) AppendHTML(buf []byte) []byte { return buf }
+
+// Class returns content that adds classes to the root tag of a component, which must be passed to Definition.New.
+func Class(classes ...string) html.Content {
+	return attr{classes: append([]string{}, classes...)}
+}
+
+// Attr returns content that sets an attribute of the root tag of a component like tag.Interface.Set, which must be
+// passed to Definition.New.
+func Attr(name string, values ...any) html.Content {
+	return attr{name: name, values: values}
+}
+
+type attr struct {
+	classes []string // if not nil, the classes to add instead of an attribute.
+	name    string
+	values  []any
+}
+
+// AppendHTML implements html.Content by appending nothing, since an attribute only has meaning to Definition.New.
+func (attr) AppendHTML(buf []byte) []byte { return buf }
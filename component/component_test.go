@@ -0,0 +1,91 @@
+package component_test
+
+import (
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/component"
+	"github.com/swdunlop/html-go/tag"
+)
+
+type cardProps struct{ Title string }
+
+var card = component.Define(`card`, func(props cardProps, slots component.Slots) tag.Interface {
+	t := tag.New(`article.card`).Add(
+		tag.New(`h2`).Text(props.Title),
+		slots.Content(),
+	)
+	if slots.Has(`footer`) {
+		t = t.Add(tag.New(`footer`).Add(slots.Get(`footer`)))
+	}
+	return t
+}, `footer`)
+
+func TestNew(t *testing.T) {
+	test := func(expect string, content html.Content) {
+		t.Helper()
+		result := string(html.Append(nil, content))
+		if result != expect {
+			t.Errorf("expected %q, got %q", expect, result)
+		}
+	}
+	test(`<article class='card'><h2>Users</h2><p>Body</p></article>`,
+		card.New(cardProps{Title: `Users`}, tag.New(`p`).Text(`Body`)))
+	test(`<article id='users' class='card wide' hidden><h2>Users</h2>A<b>B</b><footer>Save</footer></article>`,
+		card.New(cardProps{Title: `Users`},
+			component.Class(`wide`),
+			html.Text(`A`),
+			component.Slot(`footer`, html.Text(`Save`)),
+			component.Attr(`id`, `users`),
+			tag.New(`b`).Text(`B`),
+			component.Attr(`hidden`),
+		))
+
+	withDefault := card.Default(`footer`, html.Text(`None`))
+	test(`<article class='card'><h2>A</h2><footer>None</footer></article>`, withDefault.New(cardProps{Title: `A`}))
+	test(`<article class='card'><h2>A</h2><footer>Some</footer></article>`,
+		withDefault.New(cardProps{Title: `A`}, component.Slot(`footer`, html.Text(`Some`))))
+	test(`<article class='card'><h2>A</h2><footer>None</footer></article>`,
+		withDefault.New(cardProps{Title: `A`}, component.Slot(`footer`)))
+	test(`<article class='card'><h2>A</h2></article>`, card.New(cardProps{Title: `A`}))
+	test(`<article class='card wide'><h2>A</h2><p>Body</p><footer>Save</footer></article>`,
+		card.New(cardProps{Title: `A`}, html.Group{
+			component.Class(`wide`),
+			html.Group{tag.New(`p`).Text(`Body`), component.Slot(`footer`, html.Text(`Save`))},
+		}))
+
+	// a slot is declared even if a render does not use it, so it does not depend on the props.
+	optional := component.Define(`optional`, func(props bool, slots component.Slots) tag.Interface {
+		if props {
+			return tag.New(`div`).Add(slots.Get(`extra`))
+		}
+		return tag.New(`div`)
+	}, `extra`)
+	test(`<div></div>`, optional.New(false, component.Slot(`extra`, html.Text(`Extra`))))
+	test(`<div>Extra</div>`, optional.New(true, component.Slot(`extra`, html.Text(`Extra`))))
+
+	if id := card.New(cardProps{}, component.Attr(`id`, `x`)).ID(); id != `x` {
+		t.Errorf("expected the root tag to have the id, got %q", id)
+	}
+}
+
+func TestUnknownSlot(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic for an unknown slot")
+		} else if err, ok := r.(error); !ok || err.Error() != `component card does not have a "header" slot` {
+			t.Errorf("unexpected panic: %v", r)
+		}
+	}()
+	card.New(cardProps{}, component.Slot(`header`, html.Text(`Oops`)))
+}
+
+func TestDefaultDeclaresSlot(t *testing.T) {
+	def := component.Define(`box`, func(props struct{}, slots component.Slots) tag.Interface {
+		return tag.New(`div`)
+	}).Default(`title`, html.Text(`Untitled`))
+	result := string(html.Append(nil, def.New(struct{}{}, component.Slot(`title`, html.Text(`T`)))))
+	if result != `<div></div>` {
+		t.Errorf("expected a slot declared by Default to be accepted, got %q", result)
+	}
+}
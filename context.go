@@ -0,0 +1,59 @@
+package html
+
+import "context"
+
+// A ContextContent is Content that can use a context while it is rendered, letting deferred content read
+// request-scoped values like the user or locale, or stop rendering once the request has been cancelled.
+type ContextContent interface {
+	Content
+	AppendHTMLContext(ctx context.Context, buf []byte) []byte
+}
+
+// AppendContext appends the HTML from each of its elements to the provided buffer like Append, but passes ctx to
+// any element that implements ContextContent.  Elements that only implement Content are appended with AppendHTML.
+//
+// If ctx is cancelled, AppendContext stops early and returns the incomplete buffer, so callers should check ctx.Err()
+// before using the result.  While ctx is searching for a Fragment, elements that do not implement ContextContent are
+// skipped, since they cannot contain the fragment.
+func AppendContext(ctx context.Context, buf []byte, elements ...Content) []byte {
+	search := searchOf(ctx)
+	for _, element := range elements {
+		if ctx.Err() != nil || search != nil && len(search.ids) == 0 {
+			return buf
+		}
+		if content, ok := element.(ContextContent); ok {
+			buf = content.AppendHTMLContext(ctx, buf)
+		} else if search == nil {
+			buf = element.AppendHTML(buf)
+		}
+	}
+	return buf
+}
+
+// AppendHTMLContext implements ContextContent by appending each of its elements with AppendContext.
+func (group Group) AppendHTMLContext(ctx context.Context, buf []byte) []byte {
+	return AppendContext(ctx, buf, group...)
+}
+
+// AppendHTMLContext implements ContextContent by calling the function and appending its content with AppendContext.
+func (fn Func) AppendHTMLContext(ctx context.Context, buf []byte) []byte {
+	return AppendContext(ctx, buf, fn())
+}
+
+// A ContextFunc is content that only generates content when needed, using the context it is rendered with.  When
+// appended without a context, it is given context.Background().
+type ContextFunc func(ctx context.Context) Content
+
+// AppendHTML implements Content by calling the function with context.Background() to get the content.
+func (fn ContextFunc) AppendHTML(buf []byte) []byte {
+	return fn.AppendHTMLContext(context.Background(), buf)
+}
+
+// AppendHTMLContext implements ContextContent by calling the function with ctx and appending the content it returns.
+func (fn ContextFunc) AppendHTMLContext(ctx context.Context, buf []byte) []byte {
+	return AppendContext(ctx, buf, fn(ctx))
+}
+
+// StreamHTML implements Streamer by calling the function with the writer's context and rendering the content it
+// returns.
+func (fn ContextFunc) StreamHTML(w *Writer) error { return w.Render(fn(w.Context())) }
@@ -0,0 +1,63 @@
+package html_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+)
+
+type userKey struct{}
+
+func TestAppendContext(t *testing.T) {
+	greeting := tag.New(`p`).DeferContext(func(ctx context.Context) html.Content {
+		user, _ := ctx.Value(userKey{}).(string)
+		if user == `` {
+			user = `stranger`
+		}
+		return html.Text(`Hello, ` + user)
+	})
+	page := tag.New(`main`).Add(html.Group{
+		html.Func(func() html.Content { return greeting }),
+	})
+
+	ctx := context.WithValue(context.Background(), userKey{}, `alice`)
+	if got, expect := string(html.AppendContext(ctx, nil, page)), `<main><p>Hello, alice</p></main>`; got != expect {
+		t.Errorf("got %q, expected %q", got, expect)
+	}
+	if got, expect := string(html.Append(nil, page)), `<main><p>Hello, stranger</p></main>`; got != expect {
+		t.Errorf("got %q, expected %q", got, expect)
+	}
+}
+
+func TestAppendContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rendered := 0
+	item := html.ContextFunc(func(ctx context.Context) html.Content {
+		rendered++
+		if rendered == 3 {
+			cancel()
+		}
+		return html.Text(`item`)
+	})
+	group := html.Group{item, item, item, item, item}
+	html.AppendContext(ctx, nil, group)
+	if rendered != 3 {
+		t.Errorf("rendered %v items, expected rendering to stop after 3", rendered)
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	rendered = 0
+	_, err := html.WriteToContext(ctx, io.Discard, group)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected WriteToContext to return context.Canceled, got %v", err)
+	}
+	if rendered != 3 {
+		t.Errorf("streamed %v items, expected streaming to stop after 3", rendered)
+	}
+}
@@ -66,7 +66,7 @@ func RequestStream(w http.ResponseWriter, r *http.Request) (Stream, error) {
 // after each Emit call.  Unlike RequestStream, this does not negotiate content types or write HTTP headers, so
 // the caller is responsible for ensuring the client accepts SSE and that the appropriate headers are set.
 func NewStream(w io.Writer) Stream {
-	s := stream{buf: make([]byte, 0, 16384), out: w}
+	s := stream{out: w}
 	if f, ok := w.(http.Flusher); ok {
 		s.flusher = f
 	}
@@ -98,20 +98,17 @@ func (err httpError) Error() string   { return err.err.Error() }
 func (err httpError) HTTPStatus() int { return err.status }
 
 type stream struct {
-	buf     []byte
 	out     io.Writer
 	flusher http.Flusher
 }
 
 func (sm stream) Emit(events ...Event) error {
-	buf := sm.buf
-	defer func() {
-		sm.buf = buf[0:0:cap(buf)]
-	}()
+	buf := html.GetBuffer(html.DefaultChunkSize)
+	defer html.PutBuffer(buf)
 	for _, event := range events {
-		buf = event.appendEvent(buf)
+		*buf = event.appendEvent(*buf)
 	}
-	_, err := sm.out.Write(buf)
+	_, err := sm.out.Write(*buf)
 	if err == nil && sm.flusher != nil {
 		sm.flusher.Flush()
 	}
@@ -126,11 +123,12 @@ type Stream interface {
 
 // Batch takes a set of events and makes a static []byte that is (marginally) faster to send.
 func Batch(events ...Event) Event {
-	buf := make([]byte, 0, 1024)
+	buf := html.GetBuffer(html.DefaultChunkSize)
+	defer html.PutBuffer(buf)
 	for _, event := range events {
-		buf = event.appendEvent(buf)
+		*buf = event.appendEvent(*buf)
 	}
-	return batch(buf)
+	return batch(slices.Clone(*buf))
 }
 
 type batch []byte
@@ -207,7 +205,10 @@ func (p *elements) appendEvent(buf []byte) []byte {
 	)
 
 	// First generate the HTML content
-	contentBytes := p.content.AppendHTML(nil)
+	content := html.GetBuffer(html.DefaultChunkSize)
+	defer html.PutBuffer(content)
+	*content = p.content.AppendHTML(*content)
+	contentBytes := *content
 
 	sz := len(eventPrefix) + len(elementsPrefix) + len(contentBytes) + 1
 	if p.mode != `` {
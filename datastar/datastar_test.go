@@ -3,6 +3,7 @@ package datastar
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -549,3 +550,15 @@ func TestWriteJSON(t *testing.T) {
 	}
 }
 
+func BenchmarkEmit(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		stream := NewStream(io.Discard)
+		event := Elements(html.HTML("<div id=\"status\">\n\tReady\n</div>"), Mode(`outer`))
+		for pb.Next() {
+			if err := stream.Emit(event); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
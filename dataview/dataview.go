@@ -44,7 +44,17 @@ func FromGJSON(data gjson.Result, options ...Option) html.Content {
 	for _, option := range options {
 		option(cfg)
 	}
-	return cfg.asContent(data, ``)
+	content := cfg.asContent(data, ``)
+	if cfg.stylesheet {
+		return html.Group{html.Require(html.InlineStyle(stylesheet)), content}
+	}
+	return content
+}
+
+// WithStylesheet requires the CSS from Stylesheet with html.Require, so a page.Document adds it to its head once, no
+// matter how many dataviews are in the page.
+func WithStylesheet() Option {
+	return func(cfg *config) { cfg.stylesheet = true }
 }
 
 // Hook registers a function that replaces how a value is rendered if the path to the value matches the provided
@@ -72,6 +82,7 @@ type Option func(*config)
 type config struct {
 	hooks      []hook
 	tableHooks []tableHook
+	stylesheet bool
 }
 
 type hook struct {
@@ -3,7 +3,7 @@
 package deadmanswitch
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"strings"
@@ -19,7 +19,7 @@ func New(options ...Option) Interface {
 	for _, option := range options {
 		option(cfg)
 	}
-	cfg.assembleHTML()
+	cfg.assembleScript()
 	return cfg
 }
 
@@ -90,16 +90,22 @@ type Interface interface {
 }
 
 type config struct {
-	path  string
-	exprs []string
-	html  []byte
+	path   string
+	exprs  []string
+	script html.InlineScript
 }
 
 // Path implements Interface by returning the expected path for SSE connections.
 func (cfg *config) Path() string { return cfg.path }
 
-// AppendHTML implements html.Content by appendin
-func (cfg *config) AppendHTML(p []byte) []byte { return append(p, cfg.html...) }
+// AppendHTML implements html.Content by appending the script for the switch.
+func (cfg *config) AppendHTML(p []byte) []byte { return cfg.AppendHTMLContext(context.Background(), p) }
+
+// AppendHTMLContext implements html.ContextContent by requiring the script for the switch with html.Require, so a
+// page.Document adds it to its head once, even if the switch is added to the page more than once.
+func (cfg *config) AppendHTMLContext(ctx context.Context, p []byte) []byte {
+	return html.AppendContext(ctx, p, html.Require(cfg.script))
+}
 
 // ServeHTTP implements http.Handler by accepting inbound SSE connections and holding them until the provided context
 // is cancelled or the connection is lost.
@@ -118,8 +124,8 @@ func (cfg *config) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	<-r.Context().Done()
 }
 
-func (cfg *config) assembleHTML() {
-	var buf bytes.Buffer
+func (cfg *config) assembleScript() {
+	var buf strings.Builder
 	buf.WriteString(beforePath)
 	p, err := json.Marshal(cfg.path)
 	if err != nil {
@@ -133,7 +139,7 @@ func (cfg *config) assembleHTML() {
 		buf.WriteByte('\n')
 	}
 	buf.WriteString(afterExprs)
-	cfg.html = buf.Bytes()
+	cfg.script = html.InlineScript(buf.String())
 }
 
 // The script closes its EventSource on pagehide and reopens it on pageshow.
@@ -144,7 +150,7 @@ func (cfg *config) assembleHTML() {
 // from that cache reopens through the same state machine as any other lost
 // connection, so OnReconnect hooks fire.
 const (
-	beforePath = `<script>(function(){
+	beforePath = `(function(){
 	if (window.dms != undefined) return;
 	const dms = {on: {connect: [], disconnect: [], reconnect: []}, connected: null, sse: null};
 	window.dms = dms;
@@ -179,5 +185,5 @@ const (
 		open();
 	});
 `
-	afterExprs = "})()</script>\n"
+	afterExprs = "})()"
 )
@@ -0,0 +1,17 @@
+// Package el provides a typed constructor for every HTML element, with chainable setters for the attributes the
+// WHATWG HTML Living Standard allows on that element, like:
+//
+//	el.Input().Type(`checkbox`).Name(`accept`).Required()
+//
+// Each element embeds and implements tag.Interface, so it can be used anywhere a tag is accepted, and the methods of
+// tag.Interface are available for anything the setters do not cover, like el.Div().Unset(`hidden`).  Those methods
+// return a tag.Interface, so call the setters first, like el.P().Title(`Hint`).Class(`note`).  Setters sanitize
+// their values like tag.Interface.Set, and boolean setters, like Required, always add the attribute; use Toggle to
+// set a boolean attribute conditionally.  Setters that would share a name with a method of tag.Interface are renamed,
+// like DeferAttr for the "defer" attribute of a script.
+//
+// The elements and their attributes are generated from the table in internal/whatwg by gen.go; run "go generate"
+// after changing the table.
+package el
+
+//go:generate go run gen.go
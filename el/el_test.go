@@ -0,0 +1,28 @@
+package el_test
+
+import (
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/el"
+)
+
+func TestElements(t *testing.T) {
+	test := func(name, expect string, content html.Content) {
+		t.Helper()
+		result := string(content.AppendHTML(nil))
+		if result != expect {
+			t.Errorf("%v: expected %q, got %q", name, expect, result)
+		}
+	}
+	test(`void`, `<input type='checkbox' name='accept' required>`,
+		el.Input().Type(`checkbox`).Name(`accept`).Required())
+	test(`content`, `<p class='note' title='Hint'>Hello, <em>World</em></p>`,
+		el.P(html.Text(`Hello, `), el.Em().Text(`World`)).Title(`Hint`).Class(`note`))
+	test(`integer`, `<textarea rows='4' maxlength='200'></textarea>`, el.Textarea().Rows(4).MaxLength(200))
+	test(`number`, `<meter min='0' max='1' value='0.25'></meter>`, el.Meter().Min(0).Max(1).Value(0.25))
+	test(`toggle`, `<option value='a'>A</option>`, el.Option().Value(`a`).Selected().Toggle(`selected`, false).Text(`A`))
+	test(`sanitized`, `<a href='#ZgotmplZ'>x</a>`, el.A().Href(`javascript:alert(1)`).Text(`x`))
+	test(`renamed`, `<object data='/movie.swf'></object>`, el.Object().DataURL(`/movie.swf`))
+	test(`script`, `<script src='/app.js' defer></script>`, el.Script().Src(`/app.js`).DeferAttr())
+}
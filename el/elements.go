@@ -0,0 +1,8894 @@
+// Code generated by gen.go from internal/whatwg/elements.txt; DO NOT EDIT.
+
+package el
+
+import (
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+)
+
+// AElement is the <a> element, built on tag.Interface.
+type AElement struct{ tag.Interface }
+
+// A returns a new <a> element with the provided content.
+func A(content ...html.Content) AElement { return AElement{tag.New(`a`, content...)} }
+
+var _ tag.Interface = AElement{}
+
+// Href returns a copy of the element with the "href" attribute set to a value.
+func (e AElement) Href(value string) AElement { return AElement{e.Interface.Set(`href`, value)} }
+
+// Target returns a copy of the element with the "target" attribute set to a value.
+func (e AElement) Target(value string) AElement { return AElement{e.Interface.Set(`target`, value)} }
+
+// Download returns a copy of the element with the "download" attribute set to a value.
+func (e AElement) Download(value string) AElement {
+	return AElement{e.Interface.Set(`download`, value)}
+}
+
+// Ping returns a copy of the element with the "ping" attribute set to a value.
+func (e AElement) Ping(value string) AElement { return AElement{e.Interface.Set(`ping`, value)} }
+
+// Rel returns a copy of the element with the "rel" attribute set to a value.
+func (e AElement) Rel(value string) AElement { return AElement{e.Interface.Set(`rel`, value)} }
+
+// HrefLang returns a copy of the element with the "hreflang" attribute set to a value.
+func (e AElement) HrefLang(value string) AElement {
+	return AElement{e.Interface.Set(`hreflang`, value)}
+}
+
+// Type returns a copy of the element with the "type" attribute set to a value.
+func (e AElement) Type(value string) AElement { return AElement{e.Interface.Set(`type`, value)} }
+
+// ReferrerPolicy returns a copy of the element with the "referrerpolicy" attribute set to a value.
+func (e AElement) ReferrerPolicy(value string) AElement {
+	return AElement{e.Interface.Set(`referrerpolicy`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e AElement) AccessKey(value string) AElement {
+	return AElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e AElement) AutoFocus() AElement { return AElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e AElement) ContentEditable(value string) AElement {
+	return AElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e AElement) Dir(value string) AElement { return AElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e AElement) Draggable(value string) AElement {
+	return AElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e AElement) Hidden() AElement { return AElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e AElement) Inert() AElement { return AElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e AElement) Lang(value string) AElement { return AElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e AElement) Popover(value string) AElement { return AElement{e.Interface.Set(`popover`, value)} }
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e AElement) Role(value string) AElement { return AElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e AElement) Spellcheck(value string) AElement {
+	return AElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e AElement) TabIndex(value int) AElement { return AElement{e.Interface.Set(`tabindex`, value)} }
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e AElement) Title(value string) AElement { return AElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e AElement) Translate(value string) AElement {
+	return AElement{e.Interface.Set(`translate`, value)}
+}
+
+// AbbrElement is the <abbr> element, built on tag.Interface.
+type AbbrElement struct{ tag.Interface }
+
+// Abbr returns a new <abbr> element with the provided content.
+func Abbr(content ...html.Content) AbbrElement { return AbbrElement{tag.New(`abbr`, content...)} }
+
+var _ tag.Interface = AbbrElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e AbbrElement) AccessKey(value string) AbbrElement {
+	return AbbrElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e AbbrElement) AutoFocus() AbbrElement { return AbbrElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e AbbrElement) ContentEditable(value string) AbbrElement {
+	return AbbrElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e AbbrElement) Dir(value string) AbbrElement { return AbbrElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e AbbrElement) Draggable(value string) AbbrElement {
+	return AbbrElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e AbbrElement) Hidden() AbbrElement { return AbbrElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e AbbrElement) Inert() AbbrElement { return AbbrElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e AbbrElement) Lang(value string) AbbrElement {
+	return AbbrElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e AbbrElement) Popover(value string) AbbrElement {
+	return AbbrElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e AbbrElement) Role(value string) AbbrElement {
+	return AbbrElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e AbbrElement) Spellcheck(value string) AbbrElement {
+	return AbbrElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e AbbrElement) TabIndex(value int) AbbrElement {
+	return AbbrElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e AbbrElement) Title(value string) AbbrElement {
+	return AbbrElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e AbbrElement) Translate(value string) AbbrElement {
+	return AbbrElement{e.Interface.Set(`translate`, value)}
+}
+
+// AddressElement is the <address> element, built on tag.Interface.
+type AddressElement struct{ tag.Interface }
+
+// Address returns a new <address> element with the provided content.
+func Address(content ...html.Content) AddressElement {
+	return AddressElement{tag.New(`address`, content...)}
+}
+
+var _ tag.Interface = AddressElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e AddressElement) AccessKey(value string) AddressElement {
+	return AddressElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e AddressElement) AutoFocus() AddressElement {
+	return AddressElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e AddressElement) ContentEditable(value string) AddressElement {
+	return AddressElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e AddressElement) Dir(value string) AddressElement {
+	return AddressElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e AddressElement) Draggable(value string) AddressElement {
+	return AddressElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e AddressElement) Hidden() AddressElement { return AddressElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e AddressElement) Inert() AddressElement { return AddressElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e AddressElement) Lang(value string) AddressElement {
+	return AddressElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e AddressElement) Popover(value string) AddressElement {
+	return AddressElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e AddressElement) Role(value string) AddressElement {
+	return AddressElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e AddressElement) Spellcheck(value string) AddressElement {
+	return AddressElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e AddressElement) TabIndex(value int) AddressElement {
+	return AddressElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e AddressElement) Title(value string) AddressElement {
+	return AddressElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e AddressElement) Translate(value string) AddressElement {
+	return AddressElement{e.Interface.Set(`translate`, value)}
+}
+
+// AreaElement is the <area> element, built on tag.Interface.
+type AreaElement struct{ tag.Interface }
+
+// Area returns a new <area> element, which is a void element that cannot have content.
+func Area() AreaElement { return AreaElement{tag.New(`area`)} }
+
+var _ tag.Interface = AreaElement{}
+
+// Alt returns a copy of the element with the "alt" attribute set to a value.
+func (e AreaElement) Alt(value string) AreaElement { return AreaElement{e.Interface.Set(`alt`, value)} }
+
+// Coords returns a copy of the element with the "coords" attribute set to a value.
+func (e AreaElement) Coords(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`coords`, value)}
+}
+
+// Shape returns a copy of the element with the "shape" attribute set to a value.
+func (e AreaElement) Shape(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`shape`, value)}
+}
+
+// Href returns a copy of the element with the "href" attribute set to a value.
+func (e AreaElement) Href(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`href`, value)}
+}
+
+// Target returns a copy of the element with the "target" attribute set to a value.
+func (e AreaElement) Target(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`target`, value)}
+}
+
+// Download returns a copy of the element with the "download" attribute set to a value.
+func (e AreaElement) Download(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`download`, value)}
+}
+
+// Ping returns a copy of the element with the "ping" attribute set to a value.
+func (e AreaElement) Ping(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`ping`, value)}
+}
+
+// Rel returns a copy of the element with the "rel" attribute set to a value.
+func (e AreaElement) Rel(value string) AreaElement { return AreaElement{e.Interface.Set(`rel`, value)} }
+
+// ReferrerPolicy returns a copy of the element with the "referrerpolicy" attribute set to a value.
+func (e AreaElement) ReferrerPolicy(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`referrerpolicy`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e AreaElement) AccessKey(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e AreaElement) AutoFocus() AreaElement { return AreaElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e AreaElement) ContentEditable(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e AreaElement) Dir(value string) AreaElement { return AreaElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e AreaElement) Draggable(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e AreaElement) Hidden() AreaElement { return AreaElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e AreaElement) Inert() AreaElement { return AreaElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e AreaElement) Lang(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e AreaElement) Popover(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e AreaElement) Role(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e AreaElement) Spellcheck(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e AreaElement) TabIndex(value int) AreaElement {
+	return AreaElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e AreaElement) Title(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e AreaElement) Translate(value string) AreaElement {
+	return AreaElement{e.Interface.Set(`translate`, value)}
+}
+
+// ArticleElement is the <article> element, built on tag.Interface.
+type ArticleElement struct{ tag.Interface }
+
+// Article returns a new <article> element with the provided content.
+func Article(content ...html.Content) ArticleElement {
+	return ArticleElement{tag.New(`article`, content...)}
+}
+
+var _ tag.Interface = ArticleElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e ArticleElement) AccessKey(value string) ArticleElement {
+	return ArticleElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e ArticleElement) AutoFocus() ArticleElement {
+	return ArticleElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e ArticleElement) ContentEditable(value string) ArticleElement {
+	return ArticleElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e ArticleElement) Dir(value string) ArticleElement {
+	return ArticleElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e ArticleElement) Draggable(value string) ArticleElement {
+	return ArticleElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e ArticleElement) Hidden() ArticleElement { return ArticleElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e ArticleElement) Inert() ArticleElement { return ArticleElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e ArticleElement) Lang(value string) ArticleElement {
+	return ArticleElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e ArticleElement) Popover(value string) ArticleElement {
+	return ArticleElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e ArticleElement) Role(value string) ArticleElement {
+	return ArticleElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e ArticleElement) Spellcheck(value string) ArticleElement {
+	return ArticleElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e ArticleElement) TabIndex(value int) ArticleElement {
+	return ArticleElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e ArticleElement) Title(value string) ArticleElement {
+	return ArticleElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e ArticleElement) Translate(value string) ArticleElement {
+	return ArticleElement{e.Interface.Set(`translate`, value)}
+}
+
+// AsideElement is the <aside> element, built on tag.Interface.
+type AsideElement struct{ tag.Interface }
+
+// Aside returns a new <aside> element with the provided content.
+func Aside(content ...html.Content) AsideElement { return AsideElement{tag.New(`aside`, content...)} }
+
+var _ tag.Interface = AsideElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e AsideElement) AccessKey(value string) AsideElement {
+	return AsideElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e AsideElement) AutoFocus() AsideElement { return AsideElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e AsideElement) ContentEditable(value string) AsideElement {
+	return AsideElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e AsideElement) Dir(value string) AsideElement {
+	return AsideElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e AsideElement) Draggable(value string) AsideElement {
+	return AsideElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e AsideElement) Hidden() AsideElement { return AsideElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e AsideElement) Inert() AsideElement { return AsideElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e AsideElement) Lang(value string) AsideElement {
+	return AsideElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e AsideElement) Popover(value string) AsideElement {
+	return AsideElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e AsideElement) Role(value string) AsideElement {
+	return AsideElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e AsideElement) Spellcheck(value string) AsideElement {
+	return AsideElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e AsideElement) TabIndex(value int) AsideElement {
+	return AsideElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e AsideElement) Title(value string) AsideElement {
+	return AsideElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e AsideElement) Translate(value string) AsideElement {
+	return AsideElement{e.Interface.Set(`translate`, value)}
+}
+
+// AudioElement is the <audio> element, built on tag.Interface.
+type AudioElement struct{ tag.Interface }
+
+// Audio returns a new <audio> element with the provided content.
+func Audio(content ...html.Content) AudioElement { return AudioElement{tag.New(`audio`, content...)} }
+
+var _ tag.Interface = AudioElement{}
+
+// Src returns a copy of the element with the "src" attribute set to a value.
+func (e AudioElement) Src(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`src`, value)}
+}
+
+// CrossOrigin returns a copy of the element with the "crossorigin" attribute set to a value.
+func (e AudioElement) CrossOrigin(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`crossorigin`, value)}
+}
+
+// Preload returns a copy of the element with the "preload" attribute set to a value.
+func (e AudioElement) Preload(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`preload`, value)}
+}
+
+// AutoPlay returns a copy of the element with the boolean "autoplay" attribute.
+func (e AudioElement) AutoPlay() AudioElement { return AudioElement{e.Interface.Set(`autoplay`)} }
+
+// Loop returns a copy of the element with the boolean "loop" attribute.
+func (e AudioElement) Loop() AudioElement { return AudioElement{e.Interface.Set(`loop`)} }
+
+// Muted returns a copy of the element with the boolean "muted" attribute.
+func (e AudioElement) Muted() AudioElement { return AudioElement{e.Interface.Set(`muted`)} }
+
+// Controls returns a copy of the element with the boolean "controls" attribute.
+func (e AudioElement) Controls() AudioElement { return AudioElement{e.Interface.Set(`controls`)} }
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e AudioElement) AccessKey(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e AudioElement) AutoFocus() AudioElement { return AudioElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e AudioElement) ContentEditable(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e AudioElement) Dir(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e AudioElement) Draggable(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e AudioElement) Hidden() AudioElement { return AudioElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e AudioElement) Inert() AudioElement { return AudioElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e AudioElement) Lang(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e AudioElement) Popover(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e AudioElement) Role(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e AudioElement) Spellcheck(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e AudioElement) TabIndex(value int) AudioElement {
+	return AudioElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e AudioElement) Title(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e AudioElement) Translate(value string) AudioElement {
+	return AudioElement{e.Interface.Set(`translate`, value)}
+}
+
+// BElement is the <b> element, built on tag.Interface.
+type BElement struct{ tag.Interface }
+
+// B returns a new <b> element with the provided content.
+func B(content ...html.Content) BElement { return BElement{tag.New(`b`, content...)} }
+
+var _ tag.Interface = BElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e BElement) AccessKey(value string) BElement {
+	return BElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e BElement) AutoFocus() BElement { return BElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e BElement) ContentEditable(value string) BElement {
+	return BElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e BElement) Dir(value string) BElement { return BElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e BElement) Draggable(value string) BElement {
+	return BElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e BElement) Hidden() BElement { return BElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e BElement) Inert() BElement { return BElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e BElement) Lang(value string) BElement { return BElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e BElement) Popover(value string) BElement { return BElement{e.Interface.Set(`popover`, value)} }
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e BElement) Role(value string) BElement { return BElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e BElement) Spellcheck(value string) BElement {
+	return BElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e BElement) TabIndex(value int) BElement { return BElement{e.Interface.Set(`tabindex`, value)} }
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e BElement) Title(value string) BElement { return BElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e BElement) Translate(value string) BElement {
+	return BElement{e.Interface.Set(`translate`, value)}
+}
+
+// BaseElement is the <base> element, built on tag.Interface.
+type BaseElement struct{ tag.Interface }
+
+// Base returns a new <base> element, which is a void element that cannot have content.
+func Base() BaseElement { return BaseElement{tag.New(`base`)} }
+
+var _ tag.Interface = BaseElement{}
+
+// Href returns a copy of the element with the "href" attribute set to a value.
+func (e BaseElement) Href(value string) BaseElement {
+	return BaseElement{e.Interface.Set(`href`, value)}
+}
+
+// Target returns a copy of the element with the "target" attribute set to a value.
+func (e BaseElement) Target(value string) BaseElement {
+	return BaseElement{e.Interface.Set(`target`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e BaseElement) AccessKey(value string) BaseElement {
+	return BaseElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e BaseElement) AutoFocus() BaseElement { return BaseElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e BaseElement) ContentEditable(value string) BaseElement {
+	return BaseElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e BaseElement) Dir(value string) BaseElement { return BaseElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e BaseElement) Draggable(value string) BaseElement {
+	return BaseElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e BaseElement) Hidden() BaseElement { return BaseElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e BaseElement) Inert() BaseElement { return BaseElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e BaseElement) Lang(value string) BaseElement {
+	return BaseElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e BaseElement) Popover(value string) BaseElement {
+	return BaseElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e BaseElement) Role(value string) BaseElement {
+	return BaseElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e BaseElement) Spellcheck(value string) BaseElement {
+	return BaseElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e BaseElement) TabIndex(value int) BaseElement {
+	return BaseElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e BaseElement) Title(value string) BaseElement {
+	return BaseElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e BaseElement) Translate(value string) BaseElement {
+	return BaseElement{e.Interface.Set(`translate`, value)}
+}
+
+// BdiElement is the <bdi> element, built on tag.Interface.
+type BdiElement struct{ tag.Interface }
+
+// Bdi returns a new <bdi> element with the provided content.
+func Bdi(content ...html.Content) BdiElement { return BdiElement{tag.New(`bdi`, content...)} }
+
+var _ tag.Interface = BdiElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e BdiElement) AccessKey(value string) BdiElement {
+	return BdiElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e BdiElement) AutoFocus() BdiElement { return BdiElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e BdiElement) ContentEditable(value string) BdiElement {
+	return BdiElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e BdiElement) Dir(value string) BdiElement { return BdiElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e BdiElement) Draggable(value string) BdiElement {
+	return BdiElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e BdiElement) Hidden() BdiElement { return BdiElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e BdiElement) Inert() BdiElement { return BdiElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e BdiElement) Lang(value string) BdiElement { return BdiElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e BdiElement) Popover(value string) BdiElement {
+	return BdiElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e BdiElement) Role(value string) BdiElement { return BdiElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e BdiElement) Spellcheck(value string) BdiElement {
+	return BdiElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e BdiElement) TabIndex(value int) BdiElement {
+	return BdiElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e BdiElement) Title(value string) BdiElement {
+	return BdiElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e BdiElement) Translate(value string) BdiElement {
+	return BdiElement{e.Interface.Set(`translate`, value)}
+}
+
+// BdoElement is the <bdo> element, built on tag.Interface.
+type BdoElement struct{ tag.Interface }
+
+// Bdo returns a new <bdo> element with the provided content.
+func Bdo(content ...html.Content) BdoElement { return BdoElement{tag.New(`bdo`, content...)} }
+
+var _ tag.Interface = BdoElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e BdoElement) AccessKey(value string) BdoElement {
+	return BdoElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e BdoElement) AutoFocus() BdoElement { return BdoElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e BdoElement) ContentEditable(value string) BdoElement {
+	return BdoElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e BdoElement) Dir(value string) BdoElement { return BdoElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e BdoElement) Draggable(value string) BdoElement {
+	return BdoElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e BdoElement) Hidden() BdoElement { return BdoElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e BdoElement) Inert() BdoElement { return BdoElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e BdoElement) Lang(value string) BdoElement { return BdoElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e BdoElement) Popover(value string) BdoElement {
+	return BdoElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e BdoElement) Role(value string) BdoElement { return BdoElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e BdoElement) Spellcheck(value string) BdoElement {
+	return BdoElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e BdoElement) TabIndex(value int) BdoElement {
+	return BdoElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e BdoElement) Title(value string) BdoElement {
+	return BdoElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e BdoElement) Translate(value string) BdoElement {
+	return BdoElement{e.Interface.Set(`translate`, value)}
+}
+
+// BlockquoteElement is the <blockquote> element, built on tag.Interface.
+type BlockquoteElement struct{ tag.Interface }
+
+// Blockquote returns a new <blockquote> element with the provided content.
+func Blockquote(content ...html.Content) BlockquoteElement {
+	return BlockquoteElement{tag.New(`blockquote`, content...)}
+}
+
+var _ tag.Interface = BlockquoteElement{}
+
+// Cite returns a copy of the element with the "cite" attribute set to a value.
+func (e BlockquoteElement) Cite(value string) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`cite`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e BlockquoteElement) AccessKey(value string) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e BlockquoteElement) AutoFocus() BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e BlockquoteElement) ContentEditable(value string) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e BlockquoteElement) Dir(value string) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e BlockquoteElement) Draggable(value string) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e BlockquoteElement) Hidden() BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`hidden`)}
+}
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e BlockquoteElement) Inert() BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`inert`)}
+}
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e BlockquoteElement) Lang(value string) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e BlockquoteElement) Popover(value string) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e BlockquoteElement) Role(value string) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e BlockquoteElement) Spellcheck(value string) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e BlockquoteElement) TabIndex(value int) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e BlockquoteElement) Title(value string) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e BlockquoteElement) Translate(value string) BlockquoteElement {
+	return BlockquoteElement{e.Interface.Set(`translate`, value)}
+}
+
+// BodyElement is the <body> element, built on tag.Interface.
+type BodyElement struct{ tag.Interface }
+
+// Body returns a new <body> element with the provided content.
+func Body(content ...html.Content) BodyElement { return BodyElement{tag.New(`body`, content...)} }
+
+var _ tag.Interface = BodyElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e BodyElement) AccessKey(value string) BodyElement {
+	return BodyElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e BodyElement) AutoFocus() BodyElement { return BodyElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e BodyElement) ContentEditable(value string) BodyElement {
+	return BodyElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e BodyElement) Dir(value string) BodyElement { return BodyElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e BodyElement) Draggable(value string) BodyElement {
+	return BodyElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e BodyElement) Hidden() BodyElement { return BodyElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e BodyElement) Inert() BodyElement { return BodyElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e BodyElement) Lang(value string) BodyElement {
+	return BodyElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e BodyElement) Popover(value string) BodyElement {
+	return BodyElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e BodyElement) Role(value string) BodyElement {
+	return BodyElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e BodyElement) Spellcheck(value string) BodyElement {
+	return BodyElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e BodyElement) TabIndex(value int) BodyElement {
+	return BodyElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e BodyElement) Title(value string) BodyElement {
+	return BodyElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e BodyElement) Translate(value string) BodyElement {
+	return BodyElement{e.Interface.Set(`translate`, value)}
+}
+
+// BrElement is the <br> element, built on tag.Interface.
+type BrElement struct{ tag.Interface }
+
+// Br returns a new <br> element, which is a void element that cannot have content.
+func Br() BrElement { return BrElement{tag.New(`br`)} }
+
+var _ tag.Interface = BrElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e BrElement) AccessKey(value string) BrElement {
+	return BrElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e BrElement) AutoFocus() BrElement { return BrElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e BrElement) ContentEditable(value string) BrElement {
+	return BrElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e BrElement) Dir(value string) BrElement { return BrElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e BrElement) Draggable(value string) BrElement {
+	return BrElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e BrElement) Hidden() BrElement { return BrElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e BrElement) Inert() BrElement { return BrElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e BrElement) Lang(value string) BrElement { return BrElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e BrElement) Popover(value string) BrElement {
+	return BrElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e BrElement) Role(value string) BrElement { return BrElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e BrElement) Spellcheck(value string) BrElement {
+	return BrElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e BrElement) TabIndex(value int) BrElement {
+	return BrElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e BrElement) Title(value string) BrElement { return BrElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e BrElement) Translate(value string) BrElement {
+	return BrElement{e.Interface.Set(`translate`, value)}
+}
+
+// ButtonElement is the <button> element, built on tag.Interface.
+type ButtonElement struct{ tag.Interface }
+
+// Button returns a new <button> element with the provided content.
+func Button(content ...html.Content) ButtonElement {
+	return ButtonElement{tag.New(`button`, content...)}
+}
+
+var _ tag.Interface = ButtonElement{}
+
+// Disabled returns a copy of the element with the boolean "disabled" attribute.
+func (e ButtonElement) Disabled() ButtonElement { return ButtonElement{e.Interface.Set(`disabled`)} }
+
+// Form returns a copy of the element with the "form" attribute set to a value.
+func (e ButtonElement) Form(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`form`, value)}
+}
+
+// FormAction returns a copy of the element with the "formaction" attribute set to a value.
+func (e ButtonElement) FormAction(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`formaction`, value)}
+}
+
+// FormEnctype returns a copy of the element with the "formenctype" attribute set to a value.
+func (e ButtonElement) FormEnctype(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`formenctype`, value)}
+}
+
+// FormMethod returns a copy of the element with the "formmethod" attribute set to a value.
+func (e ButtonElement) FormMethod(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`formmethod`, value)}
+}
+
+// FormNoValidate returns a copy of the element with the boolean "formnovalidate" attribute.
+func (e ButtonElement) FormNoValidate() ButtonElement {
+	return ButtonElement{e.Interface.Set(`formnovalidate`)}
+}
+
+// FormTarget returns a copy of the element with the "formtarget" attribute set to a value.
+func (e ButtonElement) FormTarget(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`formtarget`, value)}
+}
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e ButtonElement) Name(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`name`, value)}
+}
+
+// PopoverTarget returns a copy of the element with the "popovertarget" attribute set to a value.
+func (e ButtonElement) PopoverTarget(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`popovertarget`, value)}
+}
+
+// PopoverTargetAction returns a copy of the element with the "popovertargetaction" attribute set to a value.
+func (e ButtonElement) PopoverTargetAction(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`popovertargetaction`, value)}
+}
+
+// Type returns a copy of the element with the "type" attribute set to a value.
+func (e ButtonElement) Type(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`type`, value)}
+}
+
+// Value returns a copy of the element with the "value" attribute set to a value.
+func (e ButtonElement) Value(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`value`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e ButtonElement) AccessKey(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e ButtonElement) AutoFocus() ButtonElement { return ButtonElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e ButtonElement) ContentEditable(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e ButtonElement) Dir(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e ButtonElement) Draggable(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e ButtonElement) Hidden() ButtonElement { return ButtonElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e ButtonElement) Inert() ButtonElement { return ButtonElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e ButtonElement) Lang(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e ButtonElement) Popover(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e ButtonElement) Role(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e ButtonElement) Spellcheck(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e ButtonElement) TabIndex(value int) ButtonElement {
+	return ButtonElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e ButtonElement) Title(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e ButtonElement) Translate(value string) ButtonElement {
+	return ButtonElement{e.Interface.Set(`translate`, value)}
+}
+
+// CanvasElement is the <canvas> element, built on tag.Interface.
+type CanvasElement struct{ tag.Interface }
+
+// Canvas returns a new <canvas> element with the provided content.
+func Canvas(content ...html.Content) CanvasElement {
+	return CanvasElement{tag.New(`canvas`, content...)}
+}
+
+var _ tag.Interface = CanvasElement{}
+
+// Width returns a copy of the element with the "width" attribute set to an integer.
+func (e CanvasElement) Width(value int) CanvasElement {
+	return CanvasElement{e.Interface.Set(`width`, value)}
+}
+
+// Height returns a copy of the element with the "height" attribute set to an integer.
+func (e CanvasElement) Height(value int) CanvasElement {
+	return CanvasElement{e.Interface.Set(`height`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e CanvasElement) AccessKey(value string) CanvasElement {
+	return CanvasElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e CanvasElement) AutoFocus() CanvasElement { return CanvasElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e CanvasElement) ContentEditable(value string) CanvasElement {
+	return CanvasElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e CanvasElement) Dir(value string) CanvasElement {
+	return CanvasElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e CanvasElement) Draggable(value string) CanvasElement {
+	return CanvasElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e CanvasElement) Hidden() CanvasElement { return CanvasElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e CanvasElement) Inert() CanvasElement { return CanvasElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e CanvasElement) Lang(value string) CanvasElement {
+	return CanvasElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e CanvasElement) Popover(value string) CanvasElement {
+	return CanvasElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e CanvasElement) Role(value string) CanvasElement {
+	return CanvasElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e CanvasElement) Spellcheck(value string) CanvasElement {
+	return CanvasElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e CanvasElement) TabIndex(value int) CanvasElement {
+	return CanvasElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e CanvasElement) Title(value string) CanvasElement {
+	return CanvasElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e CanvasElement) Translate(value string) CanvasElement {
+	return CanvasElement{e.Interface.Set(`translate`, value)}
+}
+
+// CaptionElement is the <caption> element, built on tag.Interface.
+type CaptionElement struct{ tag.Interface }
+
+// Caption returns a new <caption> element with the provided content.
+func Caption(content ...html.Content) CaptionElement {
+	return CaptionElement{tag.New(`caption`, content...)}
+}
+
+var _ tag.Interface = CaptionElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e CaptionElement) AccessKey(value string) CaptionElement {
+	return CaptionElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e CaptionElement) AutoFocus() CaptionElement {
+	return CaptionElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e CaptionElement) ContentEditable(value string) CaptionElement {
+	return CaptionElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e CaptionElement) Dir(value string) CaptionElement {
+	return CaptionElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e CaptionElement) Draggable(value string) CaptionElement {
+	return CaptionElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e CaptionElement) Hidden() CaptionElement { return CaptionElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e CaptionElement) Inert() CaptionElement { return CaptionElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e CaptionElement) Lang(value string) CaptionElement {
+	return CaptionElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e CaptionElement) Popover(value string) CaptionElement {
+	return CaptionElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e CaptionElement) Role(value string) CaptionElement {
+	return CaptionElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e CaptionElement) Spellcheck(value string) CaptionElement {
+	return CaptionElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e CaptionElement) TabIndex(value int) CaptionElement {
+	return CaptionElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e CaptionElement) Title(value string) CaptionElement {
+	return CaptionElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e CaptionElement) Translate(value string) CaptionElement {
+	return CaptionElement{e.Interface.Set(`translate`, value)}
+}
+
+// CiteElement is the <cite> element, built on tag.Interface.
+type CiteElement struct{ tag.Interface }
+
+// Cite returns a new <cite> element with the provided content.
+func Cite(content ...html.Content) CiteElement { return CiteElement{tag.New(`cite`, content...)} }
+
+var _ tag.Interface = CiteElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e CiteElement) AccessKey(value string) CiteElement {
+	return CiteElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e CiteElement) AutoFocus() CiteElement { return CiteElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e CiteElement) ContentEditable(value string) CiteElement {
+	return CiteElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e CiteElement) Dir(value string) CiteElement { return CiteElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e CiteElement) Draggable(value string) CiteElement {
+	return CiteElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e CiteElement) Hidden() CiteElement { return CiteElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e CiteElement) Inert() CiteElement { return CiteElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e CiteElement) Lang(value string) CiteElement {
+	return CiteElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e CiteElement) Popover(value string) CiteElement {
+	return CiteElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e CiteElement) Role(value string) CiteElement {
+	return CiteElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e CiteElement) Spellcheck(value string) CiteElement {
+	return CiteElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e CiteElement) TabIndex(value int) CiteElement {
+	return CiteElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e CiteElement) Title(value string) CiteElement {
+	return CiteElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e CiteElement) Translate(value string) CiteElement {
+	return CiteElement{e.Interface.Set(`translate`, value)}
+}
+
+// CodeElement is the <code> element, built on tag.Interface.
+type CodeElement struct{ tag.Interface }
+
+// Code returns a new <code> element with the provided content.
+func Code(content ...html.Content) CodeElement { return CodeElement{tag.New(`code`, content...)} }
+
+var _ tag.Interface = CodeElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e CodeElement) AccessKey(value string) CodeElement {
+	return CodeElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e CodeElement) AutoFocus() CodeElement { return CodeElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e CodeElement) ContentEditable(value string) CodeElement {
+	return CodeElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e CodeElement) Dir(value string) CodeElement { return CodeElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e CodeElement) Draggable(value string) CodeElement {
+	return CodeElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e CodeElement) Hidden() CodeElement { return CodeElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e CodeElement) Inert() CodeElement { return CodeElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e CodeElement) Lang(value string) CodeElement {
+	return CodeElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e CodeElement) Popover(value string) CodeElement {
+	return CodeElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e CodeElement) Role(value string) CodeElement {
+	return CodeElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e CodeElement) Spellcheck(value string) CodeElement {
+	return CodeElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e CodeElement) TabIndex(value int) CodeElement {
+	return CodeElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e CodeElement) Title(value string) CodeElement {
+	return CodeElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e CodeElement) Translate(value string) CodeElement {
+	return CodeElement{e.Interface.Set(`translate`, value)}
+}
+
+// ColElement is the <col> element, built on tag.Interface.
+type ColElement struct{ tag.Interface }
+
+// Col returns a new <col> element, which is a void element that cannot have content.
+func Col() ColElement { return ColElement{tag.New(`col`)} }
+
+var _ tag.Interface = ColElement{}
+
+// Span returns a copy of the element with the "span" attribute set to an integer.
+func (e ColElement) Span(value int) ColElement { return ColElement{e.Interface.Set(`span`, value)} }
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e ColElement) AccessKey(value string) ColElement {
+	return ColElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e ColElement) AutoFocus() ColElement { return ColElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e ColElement) ContentEditable(value string) ColElement {
+	return ColElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e ColElement) Dir(value string) ColElement { return ColElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e ColElement) Draggable(value string) ColElement {
+	return ColElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e ColElement) Hidden() ColElement { return ColElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e ColElement) Inert() ColElement { return ColElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e ColElement) Lang(value string) ColElement { return ColElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e ColElement) Popover(value string) ColElement {
+	return ColElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e ColElement) Role(value string) ColElement { return ColElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e ColElement) Spellcheck(value string) ColElement {
+	return ColElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e ColElement) TabIndex(value int) ColElement {
+	return ColElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e ColElement) Title(value string) ColElement {
+	return ColElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e ColElement) Translate(value string) ColElement {
+	return ColElement{e.Interface.Set(`translate`, value)}
+}
+
+// ColgroupElement is the <colgroup> element, built on tag.Interface.
+type ColgroupElement struct{ tag.Interface }
+
+// Colgroup returns a new <colgroup> element with the provided content.
+func Colgroup(content ...html.Content) ColgroupElement {
+	return ColgroupElement{tag.New(`colgroup`, content...)}
+}
+
+var _ tag.Interface = ColgroupElement{}
+
+// Span returns a copy of the element with the "span" attribute set to an integer.
+func (e ColgroupElement) Span(value int) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`span`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e ColgroupElement) AccessKey(value string) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e ColgroupElement) AutoFocus() ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e ColgroupElement) ContentEditable(value string) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e ColgroupElement) Dir(value string) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e ColgroupElement) Draggable(value string) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e ColgroupElement) Hidden() ColgroupElement { return ColgroupElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e ColgroupElement) Inert() ColgroupElement { return ColgroupElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e ColgroupElement) Lang(value string) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e ColgroupElement) Popover(value string) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e ColgroupElement) Role(value string) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e ColgroupElement) Spellcheck(value string) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e ColgroupElement) TabIndex(value int) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e ColgroupElement) Title(value string) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e ColgroupElement) Translate(value string) ColgroupElement {
+	return ColgroupElement{e.Interface.Set(`translate`, value)}
+}
+
+// DataElement is the <data> element, built on tag.Interface.
+type DataElement struct{ tag.Interface }
+
+// Data returns a new <data> element with the provided content.
+func Data(content ...html.Content) DataElement { return DataElement{tag.New(`data`, content...)} }
+
+var _ tag.Interface = DataElement{}
+
+// Value returns a copy of the element with the "value" attribute set to a value.
+func (e DataElement) Value(value string) DataElement {
+	return DataElement{e.Interface.Set(`value`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e DataElement) AccessKey(value string) DataElement {
+	return DataElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e DataElement) AutoFocus() DataElement { return DataElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e DataElement) ContentEditable(value string) DataElement {
+	return DataElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e DataElement) Dir(value string) DataElement { return DataElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e DataElement) Draggable(value string) DataElement {
+	return DataElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e DataElement) Hidden() DataElement { return DataElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e DataElement) Inert() DataElement { return DataElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e DataElement) Lang(value string) DataElement {
+	return DataElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e DataElement) Popover(value string) DataElement {
+	return DataElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e DataElement) Role(value string) DataElement {
+	return DataElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e DataElement) Spellcheck(value string) DataElement {
+	return DataElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e DataElement) TabIndex(value int) DataElement {
+	return DataElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e DataElement) Title(value string) DataElement {
+	return DataElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e DataElement) Translate(value string) DataElement {
+	return DataElement{e.Interface.Set(`translate`, value)}
+}
+
+// DatalistElement is the <datalist> element, built on tag.Interface.
+type DatalistElement struct{ tag.Interface }
+
+// Datalist returns a new <datalist> element with the provided content.
+func Datalist(content ...html.Content) DatalistElement {
+	return DatalistElement{tag.New(`datalist`, content...)}
+}
+
+var _ tag.Interface = DatalistElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e DatalistElement) AccessKey(value string) DatalistElement {
+	return DatalistElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e DatalistElement) AutoFocus() DatalistElement {
+	return DatalistElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e DatalistElement) ContentEditable(value string) DatalistElement {
+	return DatalistElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e DatalistElement) Dir(value string) DatalistElement {
+	return DatalistElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e DatalistElement) Draggable(value string) DatalistElement {
+	return DatalistElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e DatalistElement) Hidden() DatalistElement { return DatalistElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e DatalistElement) Inert() DatalistElement { return DatalistElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e DatalistElement) Lang(value string) DatalistElement {
+	return DatalistElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e DatalistElement) Popover(value string) DatalistElement {
+	return DatalistElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e DatalistElement) Role(value string) DatalistElement {
+	return DatalistElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e DatalistElement) Spellcheck(value string) DatalistElement {
+	return DatalistElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e DatalistElement) TabIndex(value int) DatalistElement {
+	return DatalistElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e DatalistElement) Title(value string) DatalistElement {
+	return DatalistElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e DatalistElement) Translate(value string) DatalistElement {
+	return DatalistElement{e.Interface.Set(`translate`, value)}
+}
+
+// DdElement is the <dd> element, built on tag.Interface.
+type DdElement struct{ tag.Interface }
+
+// Dd returns a new <dd> element with the provided content.
+func Dd(content ...html.Content) DdElement { return DdElement{tag.New(`dd`, content...)} }
+
+var _ tag.Interface = DdElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e DdElement) AccessKey(value string) DdElement {
+	return DdElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e DdElement) AutoFocus() DdElement { return DdElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e DdElement) ContentEditable(value string) DdElement {
+	return DdElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e DdElement) Dir(value string) DdElement { return DdElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e DdElement) Draggable(value string) DdElement {
+	return DdElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e DdElement) Hidden() DdElement { return DdElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e DdElement) Inert() DdElement { return DdElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e DdElement) Lang(value string) DdElement { return DdElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e DdElement) Popover(value string) DdElement {
+	return DdElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e DdElement) Role(value string) DdElement { return DdElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e DdElement) Spellcheck(value string) DdElement {
+	return DdElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e DdElement) TabIndex(value int) DdElement {
+	return DdElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e DdElement) Title(value string) DdElement { return DdElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e DdElement) Translate(value string) DdElement {
+	return DdElement{e.Interface.Set(`translate`, value)}
+}
+
+// DelElement is the <del> element, built on tag.Interface.
+type DelElement struct{ tag.Interface }
+
+// Del returns a new <del> element with the provided content.
+func Del(content ...html.Content) DelElement { return DelElement{tag.New(`del`, content...)} }
+
+var _ tag.Interface = DelElement{}
+
+// Cite returns a copy of the element with the "cite" attribute set to a value.
+func (e DelElement) Cite(value string) DelElement { return DelElement{e.Interface.Set(`cite`, value)} }
+
+// DateTime returns a copy of the element with the "datetime" attribute set to a value.
+func (e DelElement) DateTime(value string) DelElement {
+	return DelElement{e.Interface.Set(`datetime`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e DelElement) AccessKey(value string) DelElement {
+	return DelElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e DelElement) AutoFocus() DelElement { return DelElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e DelElement) ContentEditable(value string) DelElement {
+	return DelElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e DelElement) Dir(value string) DelElement { return DelElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e DelElement) Draggable(value string) DelElement {
+	return DelElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e DelElement) Hidden() DelElement { return DelElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e DelElement) Inert() DelElement { return DelElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e DelElement) Lang(value string) DelElement { return DelElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e DelElement) Popover(value string) DelElement {
+	return DelElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e DelElement) Role(value string) DelElement { return DelElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e DelElement) Spellcheck(value string) DelElement {
+	return DelElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e DelElement) TabIndex(value int) DelElement {
+	return DelElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e DelElement) Title(value string) DelElement {
+	return DelElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e DelElement) Translate(value string) DelElement {
+	return DelElement{e.Interface.Set(`translate`, value)}
+}
+
+// DetailsElement is the <details> element, built on tag.Interface.
+type DetailsElement struct{ tag.Interface }
+
+// Details returns a new <details> element with the provided content.
+func Details(content ...html.Content) DetailsElement {
+	return DetailsElement{tag.New(`details`, content...)}
+}
+
+var _ tag.Interface = DetailsElement{}
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e DetailsElement) Name(value string) DetailsElement {
+	return DetailsElement{e.Interface.Set(`name`, value)}
+}
+
+// Open returns a copy of the element with the boolean "open" attribute.
+func (e DetailsElement) Open() DetailsElement { return DetailsElement{e.Interface.Set(`open`)} }
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e DetailsElement) AccessKey(value string) DetailsElement {
+	return DetailsElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e DetailsElement) AutoFocus() DetailsElement {
+	return DetailsElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e DetailsElement) ContentEditable(value string) DetailsElement {
+	return DetailsElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e DetailsElement) Dir(value string) DetailsElement {
+	return DetailsElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e DetailsElement) Draggable(value string) DetailsElement {
+	return DetailsElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e DetailsElement) Hidden() DetailsElement { return DetailsElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e DetailsElement) Inert() DetailsElement { return DetailsElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e DetailsElement) Lang(value string) DetailsElement {
+	return DetailsElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e DetailsElement) Popover(value string) DetailsElement {
+	return DetailsElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e DetailsElement) Role(value string) DetailsElement {
+	return DetailsElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e DetailsElement) Spellcheck(value string) DetailsElement {
+	return DetailsElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e DetailsElement) TabIndex(value int) DetailsElement {
+	return DetailsElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e DetailsElement) Title(value string) DetailsElement {
+	return DetailsElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e DetailsElement) Translate(value string) DetailsElement {
+	return DetailsElement{e.Interface.Set(`translate`, value)}
+}
+
+// DfnElement is the <dfn> element, built on tag.Interface.
+type DfnElement struct{ tag.Interface }
+
+// Dfn returns a new <dfn> element with the provided content.
+func Dfn(content ...html.Content) DfnElement { return DfnElement{tag.New(`dfn`, content...)} }
+
+var _ tag.Interface = DfnElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e DfnElement) AccessKey(value string) DfnElement {
+	return DfnElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e DfnElement) AutoFocus() DfnElement { return DfnElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e DfnElement) ContentEditable(value string) DfnElement {
+	return DfnElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e DfnElement) Dir(value string) DfnElement { return DfnElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e DfnElement) Draggable(value string) DfnElement {
+	return DfnElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e DfnElement) Hidden() DfnElement { return DfnElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e DfnElement) Inert() DfnElement { return DfnElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e DfnElement) Lang(value string) DfnElement { return DfnElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e DfnElement) Popover(value string) DfnElement {
+	return DfnElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e DfnElement) Role(value string) DfnElement { return DfnElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e DfnElement) Spellcheck(value string) DfnElement {
+	return DfnElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e DfnElement) TabIndex(value int) DfnElement {
+	return DfnElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e DfnElement) Title(value string) DfnElement {
+	return DfnElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e DfnElement) Translate(value string) DfnElement {
+	return DfnElement{e.Interface.Set(`translate`, value)}
+}
+
+// DialogElement is the <dialog> element, built on tag.Interface.
+type DialogElement struct{ tag.Interface }
+
+// Dialog returns a new <dialog> element with the provided content.
+func Dialog(content ...html.Content) DialogElement {
+	return DialogElement{tag.New(`dialog`, content...)}
+}
+
+var _ tag.Interface = DialogElement{}
+
+// Open returns a copy of the element with the boolean "open" attribute.
+func (e DialogElement) Open() DialogElement { return DialogElement{e.Interface.Set(`open`)} }
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e DialogElement) AccessKey(value string) DialogElement {
+	return DialogElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e DialogElement) AutoFocus() DialogElement { return DialogElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e DialogElement) ContentEditable(value string) DialogElement {
+	return DialogElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e DialogElement) Dir(value string) DialogElement {
+	return DialogElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e DialogElement) Draggable(value string) DialogElement {
+	return DialogElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e DialogElement) Hidden() DialogElement { return DialogElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e DialogElement) Inert() DialogElement { return DialogElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e DialogElement) Lang(value string) DialogElement {
+	return DialogElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e DialogElement) Popover(value string) DialogElement {
+	return DialogElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e DialogElement) Role(value string) DialogElement {
+	return DialogElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e DialogElement) Spellcheck(value string) DialogElement {
+	return DialogElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e DialogElement) TabIndex(value int) DialogElement {
+	return DialogElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e DialogElement) Title(value string) DialogElement {
+	return DialogElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e DialogElement) Translate(value string) DialogElement {
+	return DialogElement{e.Interface.Set(`translate`, value)}
+}
+
+// DivElement is the <div> element, built on tag.Interface.
+type DivElement struct{ tag.Interface }
+
+// Div returns a new <div> element with the provided content.
+func Div(content ...html.Content) DivElement { return DivElement{tag.New(`div`, content...)} }
+
+var _ tag.Interface = DivElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e DivElement) AccessKey(value string) DivElement {
+	return DivElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e DivElement) AutoFocus() DivElement { return DivElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e DivElement) ContentEditable(value string) DivElement {
+	return DivElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e DivElement) Dir(value string) DivElement { return DivElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e DivElement) Draggable(value string) DivElement {
+	return DivElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e DivElement) Hidden() DivElement { return DivElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e DivElement) Inert() DivElement { return DivElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e DivElement) Lang(value string) DivElement { return DivElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e DivElement) Popover(value string) DivElement {
+	return DivElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e DivElement) Role(value string) DivElement { return DivElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e DivElement) Spellcheck(value string) DivElement {
+	return DivElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e DivElement) TabIndex(value int) DivElement {
+	return DivElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e DivElement) Title(value string) DivElement {
+	return DivElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e DivElement) Translate(value string) DivElement {
+	return DivElement{e.Interface.Set(`translate`, value)}
+}
+
+// DlElement is the <dl> element, built on tag.Interface.
+type DlElement struct{ tag.Interface }
+
+// Dl returns a new <dl> element with the provided content.
+func Dl(content ...html.Content) DlElement { return DlElement{tag.New(`dl`, content...)} }
+
+var _ tag.Interface = DlElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e DlElement) AccessKey(value string) DlElement {
+	return DlElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e DlElement) AutoFocus() DlElement { return DlElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e DlElement) ContentEditable(value string) DlElement {
+	return DlElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e DlElement) Dir(value string) DlElement { return DlElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e DlElement) Draggable(value string) DlElement {
+	return DlElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e DlElement) Hidden() DlElement { return DlElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e DlElement) Inert() DlElement { return DlElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e DlElement) Lang(value string) DlElement { return DlElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e DlElement) Popover(value string) DlElement {
+	return DlElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e DlElement) Role(value string) DlElement { return DlElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e DlElement) Spellcheck(value string) DlElement {
+	return DlElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e DlElement) TabIndex(value int) DlElement {
+	return DlElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e DlElement) Title(value string) DlElement { return DlElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e DlElement) Translate(value string) DlElement {
+	return DlElement{e.Interface.Set(`translate`, value)}
+}
+
+// DtElement is the <dt> element, built on tag.Interface.
+type DtElement struct{ tag.Interface }
+
+// Dt returns a new <dt> element with the provided content.
+func Dt(content ...html.Content) DtElement { return DtElement{tag.New(`dt`, content...)} }
+
+var _ tag.Interface = DtElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e DtElement) AccessKey(value string) DtElement {
+	return DtElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e DtElement) AutoFocus() DtElement { return DtElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e DtElement) ContentEditable(value string) DtElement {
+	return DtElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e DtElement) Dir(value string) DtElement { return DtElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e DtElement) Draggable(value string) DtElement {
+	return DtElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e DtElement) Hidden() DtElement { return DtElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e DtElement) Inert() DtElement { return DtElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e DtElement) Lang(value string) DtElement { return DtElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e DtElement) Popover(value string) DtElement {
+	return DtElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e DtElement) Role(value string) DtElement { return DtElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e DtElement) Spellcheck(value string) DtElement {
+	return DtElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e DtElement) TabIndex(value int) DtElement {
+	return DtElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e DtElement) Title(value string) DtElement { return DtElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e DtElement) Translate(value string) DtElement {
+	return DtElement{e.Interface.Set(`translate`, value)}
+}
+
+// EmElement is the <em> element, built on tag.Interface.
+type EmElement struct{ tag.Interface }
+
+// Em returns a new <em> element with the provided content.
+func Em(content ...html.Content) EmElement { return EmElement{tag.New(`em`, content...)} }
+
+var _ tag.Interface = EmElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e EmElement) AccessKey(value string) EmElement {
+	return EmElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e EmElement) AutoFocus() EmElement { return EmElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e EmElement) ContentEditable(value string) EmElement {
+	return EmElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e EmElement) Dir(value string) EmElement { return EmElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e EmElement) Draggable(value string) EmElement {
+	return EmElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e EmElement) Hidden() EmElement { return EmElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e EmElement) Inert() EmElement { return EmElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e EmElement) Lang(value string) EmElement { return EmElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e EmElement) Popover(value string) EmElement {
+	return EmElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e EmElement) Role(value string) EmElement { return EmElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e EmElement) Spellcheck(value string) EmElement {
+	return EmElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e EmElement) TabIndex(value int) EmElement {
+	return EmElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e EmElement) Title(value string) EmElement { return EmElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e EmElement) Translate(value string) EmElement {
+	return EmElement{e.Interface.Set(`translate`, value)}
+}
+
+// EmbedElement is the <embed> element, built on tag.Interface.
+type EmbedElement struct{ tag.Interface }
+
+// Embed returns a new <embed> element, which is a void element that cannot have content.
+func Embed() EmbedElement { return EmbedElement{tag.New(`embed`)} }
+
+var _ tag.Interface = EmbedElement{}
+
+// Src returns a copy of the element with the "src" attribute set to a value.
+func (e EmbedElement) Src(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`src`, value)}
+}
+
+// Type returns a copy of the element with the "type" attribute set to a value.
+func (e EmbedElement) Type(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`type`, value)}
+}
+
+// Width returns a copy of the element with the "width" attribute set to an integer.
+func (e EmbedElement) Width(value int) EmbedElement {
+	return EmbedElement{e.Interface.Set(`width`, value)}
+}
+
+// Height returns a copy of the element with the "height" attribute set to an integer.
+func (e EmbedElement) Height(value int) EmbedElement {
+	return EmbedElement{e.Interface.Set(`height`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e EmbedElement) AccessKey(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e EmbedElement) AutoFocus() EmbedElement { return EmbedElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e EmbedElement) ContentEditable(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e EmbedElement) Dir(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e EmbedElement) Draggable(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e EmbedElement) Hidden() EmbedElement { return EmbedElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e EmbedElement) Inert() EmbedElement { return EmbedElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e EmbedElement) Lang(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e EmbedElement) Popover(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e EmbedElement) Role(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e EmbedElement) Spellcheck(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e EmbedElement) TabIndex(value int) EmbedElement {
+	return EmbedElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e EmbedElement) Title(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e EmbedElement) Translate(value string) EmbedElement {
+	return EmbedElement{e.Interface.Set(`translate`, value)}
+}
+
+// FieldsetElement is the <fieldset> element, built on tag.Interface.
+type FieldsetElement struct{ tag.Interface }
+
+// Fieldset returns a new <fieldset> element with the provided content.
+func Fieldset(content ...html.Content) FieldsetElement {
+	return FieldsetElement{tag.New(`fieldset`, content...)}
+}
+
+var _ tag.Interface = FieldsetElement{}
+
+// Disabled returns a copy of the element with the boolean "disabled" attribute.
+func (e FieldsetElement) Disabled() FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`disabled`)}
+}
+
+// Form returns a copy of the element with the "form" attribute set to a value.
+func (e FieldsetElement) Form(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`form`, value)}
+}
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e FieldsetElement) Name(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`name`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e FieldsetElement) AccessKey(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e FieldsetElement) AutoFocus() FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e FieldsetElement) ContentEditable(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e FieldsetElement) Dir(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e FieldsetElement) Draggable(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e FieldsetElement) Hidden() FieldsetElement { return FieldsetElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e FieldsetElement) Inert() FieldsetElement { return FieldsetElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e FieldsetElement) Lang(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e FieldsetElement) Popover(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e FieldsetElement) Role(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e FieldsetElement) Spellcheck(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e FieldsetElement) TabIndex(value int) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e FieldsetElement) Title(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e FieldsetElement) Translate(value string) FieldsetElement {
+	return FieldsetElement{e.Interface.Set(`translate`, value)}
+}
+
+// FigcaptionElement is the <figcaption> element, built on tag.Interface.
+type FigcaptionElement struct{ tag.Interface }
+
+// Figcaption returns a new <figcaption> element with the provided content.
+func Figcaption(content ...html.Content) FigcaptionElement {
+	return FigcaptionElement{tag.New(`figcaption`, content...)}
+}
+
+var _ tag.Interface = FigcaptionElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e FigcaptionElement) AccessKey(value string) FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e FigcaptionElement) AutoFocus() FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e FigcaptionElement) ContentEditable(value string) FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e FigcaptionElement) Dir(value string) FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e FigcaptionElement) Draggable(value string) FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e FigcaptionElement) Hidden() FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`hidden`)}
+}
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e FigcaptionElement) Inert() FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`inert`)}
+}
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e FigcaptionElement) Lang(value string) FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e FigcaptionElement) Popover(value string) FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e FigcaptionElement) Role(value string) FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e FigcaptionElement) Spellcheck(value string) FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e FigcaptionElement) TabIndex(value int) FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e FigcaptionElement) Title(value string) FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e FigcaptionElement) Translate(value string) FigcaptionElement {
+	return FigcaptionElement{e.Interface.Set(`translate`, value)}
+}
+
+// FigureElement is the <figure> element, built on tag.Interface.
+type FigureElement struct{ tag.Interface }
+
+// Figure returns a new <figure> element with the provided content.
+func Figure(content ...html.Content) FigureElement {
+	return FigureElement{tag.New(`figure`, content...)}
+}
+
+var _ tag.Interface = FigureElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e FigureElement) AccessKey(value string) FigureElement {
+	return FigureElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e FigureElement) AutoFocus() FigureElement { return FigureElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e FigureElement) ContentEditable(value string) FigureElement {
+	return FigureElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e FigureElement) Dir(value string) FigureElement {
+	return FigureElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e FigureElement) Draggable(value string) FigureElement {
+	return FigureElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e FigureElement) Hidden() FigureElement { return FigureElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e FigureElement) Inert() FigureElement { return FigureElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e FigureElement) Lang(value string) FigureElement {
+	return FigureElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e FigureElement) Popover(value string) FigureElement {
+	return FigureElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e FigureElement) Role(value string) FigureElement {
+	return FigureElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e FigureElement) Spellcheck(value string) FigureElement {
+	return FigureElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e FigureElement) TabIndex(value int) FigureElement {
+	return FigureElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e FigureElement) Title(value string) FigureElement {
+	return FigureElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e FigureElement) Translate(value string) FigureElement {
+	return FigureElement{e.Interface.Set(`translate`, value)}
+}
+
+// FooterElement is the <footer> element, built on tag.Interface.
+type FooterElement struct{ tag.Interface }
+
+// Footer returns a new <footer> element with the provided content.
+func Footer(content ...html.Content) FooterElement {
+	return FooterElement{tag.New(`footer`, content...)}
+}
+
+var _ tag.Interface = FooterElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e FooterElement) AccessKey(value string) FooterElement {
+	return FooterElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e FooterElement) AutoFocus() FooterElement { return FooterElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e FooterElement) ContentEditable(value string) FooterElement {
+	return FooterElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e FooterElement) Dir(value string) FooterElement {
+	return FooterElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e FooterElement) Draggable(value string) FooterElement {
+	return FooterElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e FooterElement) Hidden() FooterElement { return FooterElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e FooterElement) Inert() FooterElement { return FooterElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e FooterElement) Lang(value string) FooterElement {
+	return FooterElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e FooterElement) Popover(value string) FooterElement {
+	return FooterElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e FooterElement) Role(value string) FooterElement {
+	return FooterElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e FooterElement) Spellcheck(value string) FooterElement {
+	return FooterElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e FooterElement) TabIndex(value int) FooterElement {
+	return FooterElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e FooterElement) Title(value string) FooterElement {
+	return FooterElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e FooterElement) Translate(value string) FooterElement {
+	return FooterElement{e.Interface.Set(`translate`, value)}
+}
+
+// FormElement is the <form> element, built on tag.Interface.
+type FormElement struct{ tag.Interface }
+
+// Form returns a new <form> element with the provided content.
+func Form(content ...html.Content) FormElement { return FormElement{tag.New(`form`, content...)} }
+
+var _ tag.Interface = FormElement{}
+
+// AcceptCharset returns a copy of the element with the "accept-charset" attribute set to a value.
+func (e FormElement) AcceptCharset(value string) FormElement {
+	return FormElement{e.Interface.Set(`accept-charset`, value)}
+}
+
+// Action returns a copy of the element with the "action" attribute set to a value.
+func (e FormElement) Action(value string) FormElement {
+	return FormElement{e.Interface.Set(`action`, value)}
+}
+
+// AutoComplete returns a copy of the element with the "autocomplete" attribute set to a value.
+func (e FormElement) AutoComplete(value string) FormElement {
+	return FormElement{e.Interface.Set(`autocomplete`, value)}
+}
+
+// Enctype returns a copy of the element with the "enctype" attribute set to a value.
+func (e FormElement) Enctype(value string) FormElement {
+	return FormElement{e.Interface.Set(`enctype`, value)}
+}
+
+// Method returns a copy of the element with the "method" attribute set to a value.
+func (e FormElement) Method(value string) FormElement {
+	return FormElement{e.Interface.Set(`method`, value)}
+}
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e FormElement) Name(value string) FormElement {
+	return FormElement{e.Interface.Set(`name`, value)}
+}
+
+// NoValidate returns a copy of the element with the boolean "novalidate" attribute.
+func (e FormElement) NoValidate() FormElement { return FormElement{e.Interface.Set(`novalidate`)} }
+
+// Rel returns a copy of the element with the "rel" attribute set to a value.
+func (e FormElement) Rel(value string) FormElement { return FormElement{e.Interface.Set(`rel`, value)} }
+
+// Target returns a copy of the element with the "target" attribute set to a value.
+func (e FormElement) Target(value string) FormElement {
+	return FormElement{e.Interface.Set(`target`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e FormElement) AccessKey(value string) FormElement {
+	return FormElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e FormElement) AutoFocus() FormElement { return FormElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e FormElement) ContentEditable(value string) FormElement {
+	return FormElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e FormElement) Dir(value string) FormElement { return FormElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e FormElement) Draggable(value string) FormElement {
+	return FormElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e FormElement) Hidden() FormElement { return FormElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e FormElement) Inert() FormElement { return FormElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e FormElement) Lang(value string) FormElement {
+	return FormElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e FormElement) Popover(value string) FormElement {
+	return FormElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e FormElement) Role(value string) FormElement {
+	return FormElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e FormElement) Spellcheck(value string) FormElement {
+	return FormElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e FormElement) TabIndex(value int) FormElement {
+	return FormElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e FormElement) Title(value string) FormElement {
+	return FormElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e FormElement) Translate(value string) FormElement {
+	return FormElement{e.Interface.Set(`translate`, value)}
+}
+
+// H1Element is the <h1> element, built on tag.Interface.
+type H1Element struct{ tag.Interface }
+
+// H1 returns a new <h1> element with the provided content.
+func H1(content ...html.Content) H1Element { return H1Element{tag.New(`h1`, content...)} }
+
+var _ tag.Interface = H1Element{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e H1Element) AccessKey(value string) H1Element {
+	return H1Element{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e H1Element) AutoFocus() H1Element { return H1Element{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e H1Element) ContentEditable(value string) H1Element {
+	return H1Element{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e H1Element) Dir(value string) H1Element { return H1Element{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e H1Element) Draggable(value string) H1Element {
+	return H1Element{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e H1Element) Hidden() H1Element { return H1Element{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e H1Element) Inert() H1Element { return H1Element{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e H1Element) Lang(value string) H1Element { return H1Element{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e H1Element) Popover(value string) H1Element {
+	return H1Element{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e H1Element) Role(value string) H1Element { return H1Element{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e H1Element) Spellcheck(value string) H1Element {
+	return H1Element{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e H1Element) TabIndex(value int) H1Element {
+	return H1Element{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e H1Element) Title(value string) H1Element { return H1Element{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e H1Element) Translate(value string) H1Element {
+	return H1Element{e.Interface.Set(`translate`, value)}
+}
+
+// H2Element is the <h2> element, built on tag.Interface.
+type H2Element struct{ tag.Interface }
+
+// H2 returns a new <h2> element with the provided content.
+func H2(content ...html.Content) H2Element { return H2Element{tag.New(`h2`, content...)} }
+
+var _ tag.Interface = H2Element{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e H2Element) AccessKey(value string) H2Element {
+	return H2Element{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e H2Element) AutoFocus() H2Element { return H2Element{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e H2Element) ContentEditable(value string) H2Element {
+	return H2Element{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e H2Element) Dir(value string) H2Element { return H2Element{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e H2Element) Draggable(value string) H2Element {
+	return H2Element{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e H2Element) Hidden() H2Element { return H2Element{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e H2Element) Inert() H2Element { return H2Element{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e H2Element) Lang(value string) H2Element { return H2Element{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e H2Element) Popover(value string) H2Element {
+	return H2Element{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e H2Element) Role(value string) H2Element { return H2Element{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e H2Element) Spellcheck(value string) H2Element {
+	return H2Element{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e H2Element) TabIndex(value int) H2Element {
+	return H2Element{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e H2Element) Title(value string) H2Element { return H2Element{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e H2Element) Translate(value string) H2Element {
+	return H2Element{e.Interface.Set(`translate`, value)}
+}
+
+// H3Element is the <h3> element, built on tag.Interface.
+type H3Element struct{ tag.Interface }
+
+// H3 returns a new <h3> element with the provided content.
+func H3(content ...html.Content) H3Element { return H3Element{tag.New(`h3`, content...)} }
+
+var _ tag.Interface = H3Element{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e H3Element) AccessKey(value string) H3Element {
+	return H3Element{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e H3Element) AutoFocus() H3Element { return H3Element{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e H3Element) ContentEditable(value string) H3Element {
+	return H3Element{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e H3Element) Dir(value string) H3Element { return H3Element{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e H3Element) Draggable(value string) H3Element {
+	return H3Element{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e H3Element) Hidden() H3Element { return H3Element{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e H3Element) Inert() H3Element { return H3Element{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e H3Element) Lang(value string) H3Element { return H3Element{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e H3Element) Popover(value string) H3Element {
+	return H3Element{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e H3Element) Role(value string) H3Element { return H3Element{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e H3Element) Spellcheck(value string) H3Element {
+	return H3Element{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e H3Element) TabIndex(value int) H3Element {
+	return H3Element{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e H3Element) Title(value string) H3Element { return H3Element{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e H3Element) Translate(value string) H3Element {
+	return H3Element{e.Interface.Set(`translate`, value)}
+}
+
+// H4Element is the <h4> element, built on tag.Interface.
+type H4Element struct{ tag.Interface }
+
+// H4 returns a new <h4> element with the provided content.
+func H4(content ...html.Content) H4Element { return H4Element{tag.New(`h4`, content...)} }
+
+var _ tag.Interface = H4Element{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e H4Element) AccessKey(value string) H4Element {
+	return H4Element{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e H4Element) AutoFocus() H4Element { return H4Element{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e H4Element) ContentEditable(value string) H4Element {
+	return H4Element{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e H4Element) Dir(value string) H4Element { return H4Element{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e H4Element) Draggable(value string) H4Element {
+	return H4Element{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e H4Element) Hidden() H4Element { return H4Element{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e H4Element) Inert() H4Element { return H4Element{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e H4Element) Lang(value string) H4Element { return H4Element{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e H4Element) Popover(value string) H4Element {
+	return H4Element{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e H4Element) Role(value string) H4Element { return H4Element{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e H4Element) Spellcheck(value string) H4Element {
+	return H4Element{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e H4Element) TabIndex(value int) H4Element {
+	return H4Element{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e H4Element) Title(value string) H4Element { return H4Element{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e H4Element) Translate(value string) H4Element {
+	return H4Element{e.Interface.Set(`translate`, value)}
+}
+
+// H5Element is the <h5> element, built on tag.Interface.
+type H5Element struct{ tag.Interface }
+
+// H5 returns a new <h5> element with the provided content.
+func H5(content ...html.Content) H5Element { return H5Element{tag.New(`h5`, content...)} }
+
+var _ tag.Interface = H5Element{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e H5Element) AccessKey(value string) H5Element {
+	return H5Element{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e H5Element) AutoFocus() H5Element { return H5Element{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e H5Element) ContentEditable(value string) H5Element {
+	return H5Element{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e H5Element) Dir(value string) H5Element { return H5Element{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e H5Element) Draggable(value string) H5Element {
+	return H5Element{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e H5Element) Hidden() H5Element { return H5Element{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e H5Element) Inert() H5Element { return H5Element{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e H5Element) Lang(value string) H5Element { return H5Element{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e H5Element) Popover(value string) H5Element {
+	return H5Element{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e H5Element) Role(value string) H5Element { return H5Element{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e H5Element) Spellcheck(value string) H5Element {
+	return H5Element{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e H5Element) TabIndex(value int) H5Element {
+	return H5Element{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e H5Element) Title(value string) H5Element { return H5Element{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e H5Element) Translate(value string) H5Element {
+	return H5Element{e.Interface.Set(`translate`, value)}
+}
+
+// H6Element is the <h6> element, built on tag.Interface.
+type H6Element struct{ tag.Interface }
+
+// H6 returns a new <h6> element with the provided content.
+func H6(content ...html.Content) H6Element { return H6Element{tag.New(`h6`, content...)} }
+
+var _ tag.Interface = H6Element{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e H6Element) AccessKey(value string) H6Element {
+	return H6Element{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e H6Element) AutoFocus() H6Element { return H6Element{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e H6Element) ContentEditable(value string) H6Element {
+	return H6Element{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e H6Element) Dir(value string) H6Element { return H6Element{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e H6Element) Draggable(value string) H6Element {
+	return H6Element{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e H6Element) Hidden() H6Element { return H6Element{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e H6Element) Inert() H6Element { return H6Element{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e H6Element) Lang(value string) H6Element { return H6Element{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e H6Element) Popover(value string) H6Element {
+	return H6Element{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e H6Element) Role(value string) H6Element { return H6Element{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e H6Element) Spellcheck(value string) H6Element {
+	return H6Element{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e H6Element) TabIndex(value int) H6Element {
+	return H6Element{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e H6Element) Title(value string) H6Element { return H6Element{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e H6Element) Translate(value string) H6Element {
+	return H6Element{e.Interface.Set(`translate`, value)}
+}
+
+// HeadElement is the <head> element, built on tag.Interface.
+type HeadElement struct{ tag.Interface }
+
+// Head returns a new <head> element with the provided content.
+func Head(content ...html.Content) HeadElement { return HeadElement{tag.New(`head`, content...)} }
+
+var _ tag.Interface = HeadElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e HeadElement) AccessKey(value string) HeadElement {
+	return HeadElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e HeadElement) AutoFocus() HeadElement { return HeadElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e HeadElement) ContentEditable(value string) HeadElement {
+	return HeadElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e HeadElement) Dir(value string) HeadElement { return HeadElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e HeadElement) Draggable(value string) HeadElement {
+	return HeadElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e HeadElement) Hidden() HeadElement { return HeadElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e HeadElement) Inert() HeadElement { return HeadElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e HeadElement) Lang(value string) HeadElement {
+	return HeadElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e HeadElement) Popover(value string) HeadElement {
+	return HeadElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e HeadElement) Role(value string) HeadElement {
+	return HeadElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e HeadElement) Spellcheck(value string) HeadElement {
+	return HeadElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e HeadElement) TabIndex(value int) HeadElement {
+	return HeadElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e HeadElement) Title(value string) HeadElement {
+	return HeadElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e HeadElement) Translate(value string) HeadElement {
+	return HeadElement{e.Interface.Set(`translate`, value)}
+}
+
+// HeaderElement is the <header> element, built on tag.Interface.
+type HeaderElement struct{ tag.Interface }
+
+// Header returns a new <header> element with the provided content.
+func Header(content ...html.Content) HeaderElement {
+	return HeaderElement{tag.New(`header`, content...)}
+}
+
+var _ tag.Interface = HeaderElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e HeaderElement) AccessKey(value string) HeaderElement {
+	return HeaderElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e HeaderElement) AutoFocus() HeaderElement { return HeaderElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e HeaderElement) ContentEditable(value string) HeaderElement {
+	return HeaderElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e HeaderElement) Dir(value string) HeaderElement {
+	return HeaderElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e HeaderElement) Draggable(value string) HeaderElement {
+	return HeaderElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e HeaderElement) Hidden() HeaderElement { return HeaderElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e HeaderElement) Inert() HeaderElement { return HeaderElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e HeaderElement) Lang(value string) HeaderElement {
+	return HeaderElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e HeaderElement) Popover(value string) HeaderElement {
+	return HeaderElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e HeaderElement) Role(value string) HeaderElement {
+	return HeaderElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e HeaderElement) Spellcheck(value string) HeaderElement {
+	return HeaderElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e HeaderElement) TabIndex(value int) HeaderElement {
+	return HeaderElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e HeaderElement) Title(value string) HeaderElement {
+	return HeaderElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e HeaderElement) Translate(value string) HeaderElement {
+	return HeaderElement{e.Interface.Set(`translate`, value)}
+}
+
+// HgroupElement is the <hgroup> element, built on tag.Interface.
+type HgroupElement struct{ tag.Interface }
+
+// Hgroup returns a new <hgroup> element with the provided content.
+func Hgroup(content ...html.Content) HgroupElement {
+	return HgroupElement{tag.New(`hgroup`, content...)}
+}
+
+var _ tag.Interface = HgroupElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e HgroupElement) AccessKey(value string) HgroupElement {
+	return HgroupElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e HgroupElement) AutoFocus() HgroupElement { return HgroupElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e HgroupElement) ContentEditable(value string) HgroupElement {
+	return HgroupElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e HgroupElement) Dir(value string) HgroupElement {
+	return HgroupElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e HgroupElement) Draggable(value string) HgroupElement {
+	return HgroupElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e HgroupElement) Hidden() HgroupElement { return HgroupElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e HgroupElement) Inert() HgroupElement { return HgroupElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e HgroupElement) Lang(value string) HgroupElement {
+	return HgroupElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e HgroupElement) Popover(value string) HgroupElement {
+	return HgroupElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e HgroupElement) Role(value string) HgroupElement {
+	return HgroupElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e HgroupElement) Spellcheck(value string) HgroupElement {
+	return HgroupElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e HgroupElement) TabIndex(value int) HgroupElement {
+	return HgroupElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e HgroupElement) Title(value string) HgroupElement {
+	return HgroupElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e HgroupElement) Translate(value string) HgroupElement {
+	return HgroupElement{e.Interface.Set(`translate`, value)}
+}
+
+// HrElement is the <hr> element, built on tag.Interface.
+type HrElement struct{ tag.Interface }
+
+// Hr returns a new <hr> element, which is a void element that cannot have content.
+func Hr() HrElement { return HrElement{tag.New(`hr`)} }
+
+var _ tag.Interface = HrElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e HrElement) AccessKey(value string) HrElement {
+	return HrElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e HrElement) AutoFocus() HrElement { return HrElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e HrElement) ContentEditable(value string) HrElement {
+	return HrElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e HrElement) Dir(value string) HrElement { return HrElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e HrElement) Draggable(value string) HrElement {
+	return HrElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e HrElement) Hidden() HrElement { return HrElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e HrElement) Inert() HrElement { return HrElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e HrElement) Lang(value string) HrElement { return HrElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e HrElement) Popover(value string) HrElement {
+	return HrElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e HrElement) Role(value string) HrElement { return HrElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e HrElement) Spellcheck(value string) HrElement {
+	return HrElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e HrElement) TabIndex(value int) HrElement {
+	return HrElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e HrElement) Title(value string) HrElement { return HrElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e HrElement) Translate(value string) HrElement {
+	return HrElement{e.Interface.Set(`translate`, value)}
+}
+
+// HtmlElement is the <html> element, built on tag.Interface.
+type HtmlElement struct{ tag.Interface }
+
+// Html returns a new <html> element with the provided content.
+func Html(content ...html.Content) HtmlElement { return HtmlElement{tag.New(`html`, content...)} }
+
+var _ tag.Interface = HtmlElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e HtmlElement) AccessKey(value string) HtmlElement {
+	return HtmlElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e HtmlElement) AutoFocus() HtmlElement { return HtmlElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e HtmlElement) ContentEditable(value string) HtmlElement {
+	return HtmlElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e HtmlElement) Dir(value string) HtmlElement { return HtmlElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e HtmlElement) Draggable(value string) HtmlElement {
+	return HtmlElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e HtmlElement) Hidden() HtmlElement { return HtmlElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e HtmlElement) Inert() HtmlElement { return HtmlElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e HtmlElement) Lang(value string) HtmlElement {
+	return HtmlElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e HtmlElement) Popover(value string) HtmlElement {
+	return HtmlElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e HtmlElement) Role(value string) HtmlElement {
+	return HtmlElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e HtmlElement) Spellcheck(value string) HtmlElement {
+	return HtmlElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e HtmlElement) TabIndex(value int) HtmlElement {
+	return HtmlElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e HtmlElement) Title(value string) HtmlElement {
+	return HtmlElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e HtmlElement) Translate(value string) HtmlElement {
+	return HtmlElement{e.Interface.Set(`translate`, value)}
+}
+
+// IElement is the <i> element, built on tag.Interface.
+type IElement struct{ tag.Interface }
+
+// I returns a new <i> element with the provided content.
+func I(content ...html.Content) IElement { return IElement{tag.New(`i`, content...)} }
+
+var _ tag.Interface = IElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e IElement) AccessKey(value string) IElement {
+	return IElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e IElement) AutoFocus() IElement { return IElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e IElement) ContentEditable(value string) IElement {
+	return IElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e IElement) Dir(value string) IElement { return IElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e IElement) Draggable(value string) IElement {
+	return IElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e IElement) Hidden() IElement { return IElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e IElement) Inert() IElement { return IElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e IElement) Lang(value string) IElement { return IElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e IElement) Popover(value string) IElement { return IElement{e.Interface.Set(`popover`, value)} }
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e IElement) Role(value string) IElement { return IElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e IElement) Spellcheck(value string) IElement {
+	return IElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e IElement) TabIndex(value int) IElement { return IElement{e.Interface.Set(`tabindex`, value)} }
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e IElement) Title(value string) IElement { return IElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e IElement) Translate(value string) IElement {
+	return IElement{e.Interface.Set(`translate`, value)}
+}
+
+// IframeElement is the <iframe> element, built on tag.Interface.
+type IframeElement struct{ tag.Interface }
+
+// Iframe returns a new <iframe> element with the provided content.
+func Iframe(content ...html.Content) IframeElement {
+	return IframeElement{tag.New(`iframe`, content...)}
+}
+
+var _ tag.Interface = IframeElement{}
+
+// Src returns a copy of the element with the "src" attribute set to a value.
+func (e IframeElement) Src(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`src`, value)}
+}
+
+// SrcDoc returns a copy of the element with the "srcdoc" attribute set to a value.
+func (e IframeElement) SrcDoc(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`srcdoc`, value)}
+}
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e IframeElement) Name(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`name`, value)}
+}
+
+// Sandbox returns a copy of the element with the "sandbox" attribute set to a value.
+func (e IframeElement) Sandbox(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`sandbox`, value)}
+}
+
+// Allow returns a copy of the element with the "allow" attribute set to a value.
+func (e IframeElement) Allow(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`allow`, value)}
+}
+
+// AllowFullscreen returns a copy of the element with the boolean "allowfullscreen" attribute.
+func (e IframeElement) AllowFullscreen() IframeElement {
+	return IframeElement{e.Interface.Set(`allowfullscreen`)}
+}
+
+// Width returns a copy of the element with the "width" attribute set to an integer.
+func (e IframeElement) Width(value int) IframeElement {
+	return IframeElement{e.Interface.Set(`width`, value)}
+}
+
+// Height returns a copy of the element with the "height" attribute set to an integer.
+func (e IframeElement) Height(value int) IframeElement {
+	return IframeElement{e.Interface.Set(`height`, value)}
+}
+
+// ReferrerPolicy returns a copy of the element with the "referrerpolicy" attribute set to a value.
+func (e IframeElement) ReferrerPolicy(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`referrerpolicy`, value)}
+}
+
+// Loading returns a copy of the element with the "loading" attribute set to a value.
+func (e IframeElement) Loading(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`loading`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e IframeElement) AccessKey(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e IframeElement) AutoFocus() IframeElement { return IframeElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e IframeElement) ContentEditable(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e IframeElement) Dir(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e IframeElement) Draggable(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e IframeElement) Hidden() IframeElement { return IframeElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e IframeElement) Inert() IframeElement { return IframeElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e IframeElement) Lang(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e IframeElement) Popover(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e IframeElement) Role(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e IframeElement) Spellcheck(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e IframeElement) TabIndex(value int) IframeElement {
+	return IframeElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e IframeElement) Title(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e IframeElement) Translate(value string) IframeElement {
+	return IframeElement{e.Interface.Set(`translate`, value)}
+}
+
+// ImgElement is the <img> element, built on tag.Interface.
+type ImgElement struct{ tag.Interface }
+
+// Img returns a new <img> element, which is a void element that cannot have content.
+func Img() ImgElement { return ImgElement{tag.New(`img`)} }
+
+var _ tag.Interface = ImgElement{}
+
+// Alt returns a copy of the element with the "alt" attribute set to a value.
+func (e ImgElement) Alt(value string) ImgElement { return ImgElement{e.Interface.Set(`alt`, value)} }
+
+// Src returns a copy of the element with the "src" attribute set to a value.
+func (e ImgElement) Src(value string) ImgElement { return ImgElement{e.Interface.Set(`src`, value)} }
+
+// SrcSet returns a copy of the element with the "srcset" attribute set to a value.
+func (e ImgElement) SrcSet(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`srcset`, value)}
+}
+
+// Sizes returns a copy of the element with the "sizes" attribute set to a value.
+func (e ImgElement) Sizes(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`sizes`, value)}
+}
+
+// CrossOrigin returns a copy of the element with the "crossorigin" attribute set to a value.
+func (e ImgElement) CrossOrigin(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`crossorigin`, value)}
+}
+
+// UseMap returns a copy of the element with the "usemap" attribute set to a value.
+func (e ImgElement) UseMap(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`usemap`, value)}
+}
+
+// IsMap returns a copy of the element with the boolean "ismap" attribute.
+func (e ImgElement) IsMap() ImgElement { return ImgElement{e.Interface.Set(`ismap`)} }
+
+// Width returns a copy of the element with the "width" attribute set to an integer.
+func (e ImgElement) Width(value int) ImgElement { return ImgElement{e.Interface.Set(`width`, value)} }
+
+// Height returns a copy of the element with the "height" attribute set to an integer.
+func (e ImgElement) Height(value int) ImgElement { return ImgElement{e.Interface.Set(`height`, value)} }
+
+// ReferrerPolicy returns a copy of the element with the "referrerpolicy" attribute set to a value.
+func (e ImgElement) ReferrerPolicy(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`referrerpolicy`, value)}
+}
+
+// Decoding returns a copy of the element with the "decoding" attribute set to a value.
+func (e ImgElement) Decoding(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`decoding`, value)}
+}
+
+// Loading returns a copy of the element with the "loading" attribute set to a value.
+func (e ImgElement) Loading(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`loading`, value)}
+}
+
+// FetchPriority returns a copy of the element with the "fetchpriority" attribute set to a value.
+func (e ImgElement) FetchPriority(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`fetchpriority`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e ImgElement) AccessKey(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e ImgElement) AutoFocus() ImgElement { return ImgElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e ImgElement) ContentEditable(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e ImgElement) Dir(value string) ImgElement { return ImgElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e ImgElement) Draggable(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e ImgElement) Hidden() ImgElement { return ImgElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e ImgElement) Inert() ImgElement { return ImgElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e ImgElement) Lang(value string) ImgElement { return ImgElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e ImgElement) Popover(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e ImgElement) Role(value string) ImgElement { return ImgElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e ImgElement) Spellcheck(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e ImgElement) TabIndex(value int) ImgElement {
+	return ImgElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e ImgElement) Title(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e ImgElement) Translate(value string) ImgElement {
+	return ImgElement{e.Interface.Set(`translate`, value)}
+}
+
+// InputElement is the <input> element, built on tag.Interface.
+type InputElement struct{ tag.Interface }
+
+// Input returns a new <input> element, which is a void element that cannot have content.
+func Input() InputElement { return InputElement{tag.New(`input`)} }
+
+var _ tag.Interface = InputElement{}
+
+// Accept returns a copy of the element with the "accept" attribute set to a value.
+func (e InputElement) Accept(value string) InputElement {
+	return InputElement{e.Interface.Set(`accept`, value)}
+}
+
+// Alt returns a copy of the element with the "alt" attribute set to a value.
+func (e InputElement) Alt(value string) InputElement {
+	return InputElement{e.Interface.Set(`alt`, value)}
+}
+
+// AutoComplete returns a copy of the element with the "autocomplete" attribute set to a value.
+func (e InputElement) AutoComplete(value string) InputElement {
+	return InputElement{e.Interface.Set(`autocomplete`, value)}
+}
+
+// Checked returns a copy of the element with the boolean "checked" attribute.
+func (e InputElement) Checked() InputElement { return InputElement{e.Interface.Set(`checked`)} }
+
+// DirName returns a copy of the element with the "dirname" attribute set to a value.
+func (e InputElement) DirName(value string) InputElement {
+	return InputElement{e.Interface.Set(`dirname`, value)}
+}
+
+// Disabled returns a copy of the element with the boolean "disabled" attribute.
+func (e InputElement) Disabled() InputElement { return InputElement{e.Interface.Set(`disabled`)} }
+
+// Form returns a copy of the element with the "form" attribute set to a value.
+func (e InputElement) Form(value string) InputElement {
+	return InputElement{e.Interface.Set(`form`, value)}
+}
+
+// FormAction returns a copy of the element with the "formaction" attribute set to a value.
+func (e InputElement) FormAction(value string) InputElement {
+	return InputElement{e.Interface.Set(`formaction`, value)}
+}
+
+// FormEnctype returns a copy of the element with the "formenctype" attribute set to a value.
+func (e InputElement) FormEnctype(value string) InputElement {
+	return InputElement{e.Interface.Set(`formenctype`, value)}
+}
+
+// FormMethod returns a copy of the element with the "formmethod" attribute set to a value.
+func (e InputElement) FormMethod(value string) InputElement {
+	return InputElement{e.Interface.Set(`formmethod`, value)}
+}
+
+// FormNoValidate returns a copy of the element with the boolean "formnovalidate" attribute.
+func (e InputElement) FormNoValidate() InputElement {
+	return InputElement{e.Interface.Set(`formnovalidate`)}
+}
+
+// FormTarget returns a copy of the element with the "formtarget" attribute set to a value.
+func (e InputElement) FormTarget(value string) InputElement {
+	return InputElement{e.Interface.Set(`formtarget`, value)}
+}
+
+// Height returns a copy of the element with the "height" attribute set to an integer.
+func (e InputElement) Height(value int) InputElement {
+	return InputElement{e.Interface.Set(`height`, value)}
+}
+
+// List returns a copy of the element with the "list" attribute set to a value.
+func (e InputElement) List(value string) InputElement {
+	return InputElement{e.Interface.Set(`list`, value)}
+}
+
+// Max returns a copy of the element with the "max" attribute set to a value.
+func (e InputElement) Max(value string) InputElement {
+	return InputElement{e.Interface.Set(`max`, value)}
+}
+
+// MaxLength returns a copy of the element with the "maxlength" attribute set to an integer.
+func (e InputElement) MaxLength(value int) InputElement {
+	return InputElement{e.Interface.Set(`maxlength`, value)}
+}
+
+// Min returns a copy of the element with the "min" attribute set to a value.
+func (e InputElement) Min(value string) InputElement {
+	return InputElement{e.Interface.Set(`min`, value)}
+}
+
+// MinLength returns a copy of the element with the "minlength" attribute set to an integer.
+func (e InputElement) MinLength(value int) InputElement {
+	return InputElement{e.Interface.Set(`minlength`, value)}
+}
+
+// Multiple returns a copy of the element with the boolean "multiple" attribute.
+func (e InputElement) Multiple() InputElement { return InputElement{e.Interface.Set(`multiple`)} }
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e InputElement) Name(value string) InputElement {
+	return InputElement{e.Interface.Set(`name`, value)}
+}
+
+// Pattern returns a copy of the element with the "pattern" attribute set to a value.
+func (e InputElement) Pattern(value string) InputElement {
+	return InputElement{e.Interface.Set(`pattern`, value)}
+}
+
+// Placeholder returns a copy of the element with the "placeholder" attribute set to a value.
+func (e InputElement) Placeholder(value string) InputElement {
+	return InputElement{e.Interface.Set(`placeholder`, value)}
+}
+
+// PopoverTarget returns a copy of the element with the "popovertarget" attribute set to a value.
+func (e InputElement) PopoverTarget(value string) InputElement {
+	return InputElement{e.Interface.Set(`popovertarget`, value)}
+}
+
+// PopoverTargetAction returns a copy of the element with the "popovertargetaction" attribute set to a value.
+func (e InputElement) PopoverTargetAction(value string) InputElement {
+	return InputElement{e.Interface.Set(`popovertargetaction`, value)}
+}
+
+// ReadOnly returns a copy of the element with the boolean "readonly" attribute.
+func (e InputElement) ReadOnly() InputElement { return InputElement{e.Interface.Set(`readonly`)} }
+
+// Required returns a copy of the element with the boolean "required" attribute.
+func (e InputElement) Required() InputElement { return InputElement{e.Interface.Set(`required`)} }
+
+// Size returns a copy of the element with the "size" attribute set to an integer.
+func (e InputElement) Size(value int) InputElement {
+	return InputElement{e.Interface.Set(`size`, value)}
+}
+
+// Src returns a copy of the element with the "src" attribute set to a value.
+func (e InputElement) Src(value string) InputElement {
+	return InputElement{e.Interface.Set(`src`, value)}
+}
+
+// Step returns a copy of the element with the "step" attribute set to a value.
+func (e InputElement) Step(value string) InputElement {
+	return InputElement{e.Interface.Set(`step`, value)}
+}
+
+// Type returns a copy of the element with the "type" attribute set to a value.
+func (e InputElement) Type(value string) InputElement {
+	return InputElement{e.Interface.Set(`type`, value)}
+}
+
+// Value returns a copy of the element with the "value" attribute set to a value.
+func (e InputElement) Value(value string) InputElement {
+	return InputElement{e.Interface.Set(`value`, value)}
+}
+
+// Width returns a copy of the element with the "width" attribute set to an integer.
+func (e InputElement) Width(value int) InputElement {
+	return InputElement{e.Interface.Set(`width`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e InputElement) AccessKey(value string) InputElement {
+	return InputElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e InputElement) AutoFocus() InputElement { return InputElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e InputElement) ContentEditable(value string) InputElement {
+	return InputElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e InputElement) Dir(value string) InputElement {
+	return InputElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e InputElement) Draggable(value string) InputElement {
+	return InputElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e InputElement) Hidden() InputElement { return InputElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e InputElement) Inert() InputElement { return InputElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e InputElement) Lang(value string) InputElement {
+	return InputElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e InputElement) Popover(value string) InputElement {
+	return InputElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e InputElement) Role(value string) InputElement {
+	return InputElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e InputElement) Spellcheck(value string) InputElement {
+	return InputElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e InputElement) TabIndex(value int) InputElement {
+	return InputElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e InputElement) Title(value string) InputElement {
+	return InputElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e InputElement) Translate(value string) InputElement {
+	return InputElement{e.Interface.Set(`translate`, value)}
+}
+
+// InsElement is the <ins> element, built on tag.Interface.
+type InsElement struct{ tag.Interface }
+
+// Ins returns a new <ins> element with the provided content.
+func Ins(content ...html.Content) InsElement { return InsElement{tag.New(`ins`, content...)} }
+
+var _ tag.Interface = InsElement{}
+
+// Cite returns a copy of the element with the "cite" attribute set to a value.
+func (e InsElement) Cite(value string) InsElement { return InsElement{e.Interface.Set(`cite`, value)} }
+
+// DateTime returns a copy of the element with the "datetime" attribute set to a value.
+func (e InsElement) DateTime(value string) InsElement {
+	return InsElement{e.Interface.Set(`datetime`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e InsElement) AccessKey(value string) InsElement {
+	return InsElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e InsElement) AutoFocus() InsElement { return InsElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e InsElement) ContentEditable(value string) InsElement {
+	return InsElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e InsElement) Dir(value string) InsElement { return InsElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e InsElement) Draggable(value string) InsElement {
+	return InsElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e InsElement) Hidden() InsElement { return InsElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e InsElement) Inert() InsElement { return InsElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e InsElement) Lang(value string) InsElement { return InsElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e InsElement) Popover(value string) InsElement {
+	return InsElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e InsElement) Role(value string) InsElement { return InsElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e InsElement) Spellcheck(value string) InsElement {
+	return InsElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e InsElement) TabIndex(value int) InsElement {
+	return InsElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e InsElement) Title(value string) InsElement {
+	return InsElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e InsElement) Translate(value string) InsElement {
+	return InsElement{e.Interface.Set(`translate`, value)}
+}
+
+// KbdElement is the <kbd> element, built on tag.Interface.
+type KbdElement struct{ tag.Interface }
+
+// Kbd returns a new <kbd> element with the provided content.
+func Kbd(content ...html.Content) KbdElement { return KbdElement{tag.New(`kbd`, content...)} }
+
+var _ tag.Interface = KbdElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e KbdElement) AccessKey(value string) KbdElement {
+	return KbdElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e KbdElement) AutoFocus() KbdElement { return KbdElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e KbdElement) ContentEditable(value string) KbdElement {
+	return KbdElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e KbdElement) Dir(value string) KbdElement { return KbdElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e KbdElement) Draggable(value string) KbdElement {
+	return KbdElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e KbdElement) Hidden() KbdElement { return KbdElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e KbdElement) Inert() KbdElement { return KbdElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e KbdElement) Lang(value string) KbdElement { return KbdElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e KbdElement) Popover(value string) KbdElement {
+	return KbdElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e KbdElement) Role(value string) KbdElement { return KbdElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e KbdElement) Spellcheck(value string) KbdElement {
+	return KbdElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e KbdElement) TabIndex(value int) KbdElement {
+	return KbdElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e KbdElement) Title(value string) KbdElement {
+	return KbdElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e KbdElement) Translate(value string) KbdElement {
+	return KbdElement{e.Interface.Set(`translate`, value)}
+}
+
+// LabelElement is the <label> element, built on tag.Interface.
+type LabelElement struct{ tag.Interface }
+
+// Label returns a new <label> element with the provided content.
+func Label(content ...html.Content) LabelElement { return LabelElement{tag.New(`label`, content...)} }
+
+var _ tag.Interface = LabelElement{}
+
+// For returns a copy of the element with the "for" attribute set to a value.
+func (e LabelElement) For(value string) LabelElement {
+	return LabelElement{e.Interface.Set(`for`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e LabelElement) AccessKey(value string) LabelElement {
+	return LabelElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e LabelElement) AutoFocus() LabelElement { return LabelElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e LabelElement) ContentEditable(value string) LabelElement {
+	return LabelElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e LabelElement) Dir(value string) LabelElement {
+	return LabelElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e LabelElement) Draggable(value string) LabelElement {
+	return LabelElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e LabelElement) Hidden() LabelElement { return LabelElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e LabelElement) Inert() LabelElement { return LabelElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e LabelElement) Lang(value string) LabelElement {
+	return LabelElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e LabelElement) Popover(value string) LabelElement {
+	return LabelElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e LabelElement) Role(value string) LabelElement {
+	return LabelElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e LabelElement) Spellcheck(value string) LabelElement {
+	return LabelElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e LabelElement) TabIndex(value int) LabelElement {
+	return LabelElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e LabelElement) Title(value string) LabelElement {
+	return LabelElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e LabelElement) Translate(value string) LabelElement {
+	return LabelElement{e.Interface.Set(`translate`, value)}
+}
+
+// LegendElement is the <legend> element, built on tag.Interface.
+type LegendElement struct{ tag.Interface }
+
+// Legend returns a new <legend> element with the provided content.
+func Legend(content ...html.Content) LegendElement {
+	return LegendElement{tag.New(`legend`, content...)}
+}
+
+var _ tag.Interface = LegendElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e LegendElement) AccessKey(value string) LegendElement {
+	return LegendElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e LegendElement) AutoFocus() LegendElement { return LegendElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e LegendElement) ContentEditable(value string) LegendElement {
+	return LegendElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e LegendElement) Dir(value string) LegendElement {
+	return LegendElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e LegendElement) Draggable(value string) LegendElement {
+	return LegendElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e LegendElement) Hidden() LegendElement { return LegendElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e LegendElement) Inert() LegendElement { return LegendElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e LegendElement) Lang(value string) LegendElement {
+	return LegendElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e LegendElement) Popover(value string) LegendElement {
+	return LegendElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e LegendElement) Role(value string) LegendElement {
+	return LegendElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e LegendElement) Spellcheck(value string) LegendElement {
+	return LegendElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e LegendElement) TabIndex(value int) LegendElement {
+	return LegendElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e LegendElement) Title(value string) LegendElement {
+	return LegendElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e LegendElement) Translate(value string) LegendElement {
+	return LegendElement{e.Interface.Set(`translate`, value)}
+}
+
+// LiElement is the <li> element, built on tag.Interface.
+type LiElement struct{ tag.Interface }
+
+// Li returns a new <li> element with the provided content.
+func Li(content ...html.Content) LiElement { return LiElement{tag.New(`li`, content...)} }
+
+var _ tag.Interface = LiElement{}
+
+// Value returns a copy of the element with the "value" attribute set to an integer.
+func (e LiElement) Value(value int) LiElement { return LiElement{e.Interface.Set(`value`, value)} }
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e LiElement) AccessKey(value string) LiElement {
+	return LiElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e LiElement) AutoFocus() LiElement { return LiElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e LiElement) ContentEditable(value string) LiElement {
+	return LiElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e LiElement) Dir(value string) LiElement { return LiElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e LiElement) Draggable(value string) LiElement {
+	return LiElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e LiElement) Hidden() LiElement { return LiElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e LiElement) Inert() LiElement { return LiElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e LiElement) Lang(value string) LiElement { return LiElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e LiElement) Popover(value string) LiElement {
+	return LiElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e LiElement) Role(value string) LiElement { return LiElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e LiElement) Spellcheck(value string) LiElement {
+	return LiElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e LiElement) TabIndex(value int) LiElement {
+	return LiElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e LiElement) Title(value string) LiElement { return LiElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e LiElement) Translate(value string) LiElement {
+	return LiElement{e.Interface.Set(`translate`, value)}
+}
+
+// LinkElement is the <link> element, built on tag.Interface.
+type LinkElement struct{ tag.Interface }
+
+// Link returns a new <link> element, which is a void element that cannot have content.
+func Link() LinkElement { return LinkElement{tag.New(`link`)} }
+
+var _ tag.Interface = LinkElement{}
+
+// Href returns a copy of the element with the "href" attribute set to a value.
+func (e LinkElement) Href(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`href`, value)}
+}
+
+// CrossOrigin returns a copy of the element with the "crossorigin" attribute set to a value.
+func (e LinkElement) CrossOrigin(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`crossorigin`, value)}
+}
+
+// Rel returns a copy of the element with the "rel" attribute set to a value.
+func (e LinkElement) Rel(value string) LinkElement { return LinkElement{e.Interface.Set(`rel`, value)} }
+
+// As returns a copy of the element with the "as" attribute set to a value.
+func (e LinkElement) As(value string) LinkElement { return LinkElement{e.Interface.Set(`as`, value)} }
+
+// Media returns a copy of the element with the "media" attribute set to a value.
+func (e LinkElement) Media(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`media`, value)}
+}
+
+// HrefLang returns a copy of the element with the "hreflang" attribute set to a value.
+func (e LinkElement) HrefLang(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`hreflang`, value)}
+}
+
+// Type returns a copy of the element with the "type" attribute set to a value.
+func (e LinkElement) Type(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`type`, value)}
+}
+
+// Sizes returns a copy of the element with the "sizes" attribute set to a value.
+func (e LinkElement) Sizes(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`sizes`, value)}
+}
+
+// ImageSrcSet returns a copy of the element with the "imagesrcset" attribute set to a value.
+func (e LinkElement) ImageSrcSet(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`imagesrcset`, value)}
+}
+
+// ImageSizes returns a copy of the element with the "imagesizes" attribute set to a value.
+func (e LinkElement) ImageSizes(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`imagesizes`, value)}
+}
+
+// ReferrerPolicy returns a copy of the element with the "referrerpolicy" attribute set to a value.
+func (e LinkElement) ReferrerPolicy(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`referrerpolicy`, value)}
+}
+
+// Integrity returns a copy of the element with the "integrity" attribute set to a value.
+func (e LinkElement) Integrity(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`integrity`, value)}
+}
+
+// Blocking returns a copy of the element with the "blocking" attribute set to a value.
+func (e LinkElement) Blocking(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`blocking`, value)}
+}
+
+// Color returns a copy of the element with the "color" attribute set to a value.
+func (e LinkElement) Color(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`color`, value)}
+}
+
+// Disabled returns a copy of the element with the boolean "disabled" attribute.
+func (e LinkElement) Disabled() LinkElement { return LinkElement{e.Interface.Set(`disabled`)} }
+
+// FetchPriority returns a copy of the element with the "fetchpriority" attribute set to a value.
+func (e LinkElement) FetchPriority(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`fetchpriority`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e LinkElement) AccessKey(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e LinkElement) AutoFocus() LinkElement { return LinkElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e LinkElement) ContentEditable(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e LinkElement) Dir(value string) LinkElement { return LinkElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e LinkElement) Draggable(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e LinkElement) Hidden() LinkElement { return LinkElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e LinkElement) Inert() LinkElement { return LinkElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e LinkElement) Lang(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e LinkElement) Popover(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e LinkElement) Role(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e LinkElement) Spellcheck(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e LinkElement) TabIndex(value int) LinkElement {
+	return LinkElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e LinkElement) Title(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e LinkElement) Translate(value string) LinkElement {
+	return LinkElement{e.Interface.Set(`translate`, value)}
+}
+
+// MainElement is the <main> element, built on tag.Interface.
+type MainElement struct{ tag.Interface }
+
+// Main returns a new <main> element with the provided content.
+func Main(content ...html.Content) MainElement { return MainElement{tag.New(`main`, content...)} }
+
+var _ tag.Interface = MainElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e MainElement) AccessKey(value string) MainElement {
+	return MainElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e MainElement) AutoFocus() MainElement { return MainElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e MainElement) ContentEditable(value string) MainElement {
+	return MainElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e MainElement) Dir(value string) MainElement { return MainElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e MainElement) Draggable(value string) MainElement {
+	return MainElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e MainElement) Hidden() MainElement { return MainElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e MainElement) Inert() MainElement { return MainElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e MainElement) Lang(value string) MainElement {
+	return MainElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e MainElement) Popover(value string) MainElement {
+	return MainElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e MainElement) Role(value string) MainElement {
+	return MainElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e MainElement) Spellcheck(value string) MainElement {
+	return MainElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e MainElement) TabIndex(value int) MainElement {
+	return MainElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e MainElement) Title(value string) MainElement {
+	return MainElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e MainElement) Translate(value string) MainElement {
+	return MainElement{e.Interface.Set(`translate`, value)}
+}
+
+// MapElement is the <map> element, built on tag.Interface.
+type MapElement struct{ tag.Interface }
+
+// Map returns a new <map> element with the provided content.
+func Map(content ...html.Content) MapElement { return MapElement{tag.New(`map`, content...)} }
+
+var _ tag.Interface = MapElement{}
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e MapElement) Name(value string) MapElement { return MapElement{e.Interface.Set(`name`, value)} }
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e MapElement) AccessKey(value string) MapElement {
+	return MapElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e MapElement) AutoFocus() MapElement { return MapElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e MapElement) ContentEditable(value string) MapElement {
+	return MapElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e MapElement) Dir(value string) MapElement { return MapElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e MapElement) Draggable(value string) MapElement {
+	return MapElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e MapElement) Hidden() MapElement { return MapElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e MapElement) Inert() MapElement { return MapElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e MapElement) Lang(value string) MapElement { return MapElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e MapElement) Popover(value string) MapElement {
+	return MapElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e MapElement) Role(value string) MapElement { return MapElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e MapElement) Spellcheck(value string) MapElement {
+	return MapElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e MapElement) TabIndex(value int) MapElement {
+	return MapElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e MapElement) Title(value string) MapElement {
+	return MapElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e MapElement) Translate(value string) MapElement {
+	return MapElement{e.Interface.Set(`translate`, value)}
+}
+
+// MarkElement is the <mark> element, built on tag.Interface.
+type MarkElement struct{ tag.Interface }
+
+// Mark returns a new <mark> element with the provided content.
+func Mark(content ...html.Content) MarkElement { return MarkElement{tag.New(`mark`, content...)} }
+
+var _ tag.Interface = MarkElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e MarkElement) AccessKey(value string) MarkElement {
+	return MarkElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e MarkElement) AutoFocus() MarkElement { return MarkElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e MarkElement) ContentEditable(value string) MarkElement {
+	return MarkElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e MarkElement) Dir(value string) MarkElement { return MarkElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e MarkElement) Draggable(value string) MarkElement {
+	return MarkElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e MarkElement) Hidden() MarkElement { return MarkElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e MarkElement) Inert() MarkElement { return MarkElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e MarkElement) Lang(value string) MarkElement {
+	return MarkElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e MarkElement) Popover(value string) MarkElement {
+	return MarkElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e MarkElement) Role(value string) MarkElement {
+	return MarkElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e MarkElement) Spellcheck(value string) MarkElement {
+	return MarkElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e MarkElement) TabIndex(value int) MarkElement {
+	return MarkElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e MarkElement) Title(value string) MarkElement {
+	return MarkElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e MarkElement) Translate(value string) MarkElement {
+	return MarkElement{e.Interface.Set(`translate`, value)}
+}
+
+// MenuElement is the <menu> element, built on tag.Interface.
+type MenuElement struct{ tag.Interface }
+
+// Menu returns a new <menu> element with the provided content.
+func Menu(content ...html.Content) MenuElement { return MenuElement{tag.New(`menu`, content...)} }
+
+var _ tag.Interface = MenuElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e MenuElement) AccessKey(value string) MenuElement {
+	return MenuElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e MenuElement) AutoFocus() MenuElement { return MenuElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e MenuElement) ContentEditable(value string) MenuElement {
+	return MenuElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e MenuElement) Dir(value string) MenuElement { return MenuElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e MenuElement) Draggable(value string) MenuElement {
+	return MenuElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e MenuElement) Hidden() MenuElement { return MenuElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e MenuElement) Inert() MenuElement { return MenuElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e MenuElement) Lang(value string) MenuElement {
+	return MenuElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e MenuElement) Popover(value string) MenuElement {
+	return MenuElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e MenuElement) Role(value string) MenuElement {
+	return MenuElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e MenuElement) Spellcheck(value string) MenuElement {
+	return MenuElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e MenuElement) TabIndex(value int) MenuElement {
+	return MenuElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e MenuElement) Title(value string) MenuElement {
+	return MenuElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e MenuElement) Translate(value string) MenuElement {
+	return MenuElement{e.Interface.Set(`translate`, value)}
+}
+
+// MetaElement is the <meta> element, built on tag.Interface.
+type MetaElement struct{ tag.Interface }
+
+// Meta returns a new <meta> element, which is a void element that cannot have content.
+func Meta() MetaElement { return MetaElement{tag.New(`meta`)} }
+
+var _ tag.Interface = MetaElement{}
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e MetaElement) Name(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`name`, value)}
+}
+
+// HTTPEquiv returns a copy of the element with the "http-equiv" attribute set to a value.
+func (e MetaElement) HTTPEquiv(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`http-equiv`, value)}
+}
+
+// Content returns a copy of the element with the "content" attribute set to a value.
+func (e MetaElement) Content(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`content`, value)}
+}
+
+// Charset returns a copy of the element with the "charset" attribute set to a value.
+func (e MetaElement) Charset(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`charset`, value)}
+}
+
+// Media returns a copy of the element with the "media" attribute set to a value.
+func (e MetaElement) Media(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`media`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e MetaElement) AccessKey(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e MetaElement) AutoFocus() MetaElement { return MetaElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e MetaElement) ContentEditable(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e MetaElement) Dir(value string) MetaElement { return MetaElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e MetaElement) Draggable(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e MetaElement) Hidden() MetaElement { return MetaElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e MetaElement) Inert() MetaElement { return MetaElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e MetaElement) Lang(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e MetaElement) Popover(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e MetaElement) Role(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e MetaElement) Spellcheck(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e MetaElement) TabIndex(value int) MetaElement {
+	return MetaElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e MetaElement) Title(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e MetaElement) Translate(value string) MetaElement {
+	return MetaElement{e.Interface.Set(`translate`, value)}
+}
+
+// MeterElement is the <meter> element, built on tag.Interface.
+type MeterElement struct{ tag.Interface }
+
+// Meter returns a new <meter> element with the provided content.
+func Meter(content ...html.Content) MeterElement { return MeterElement{tag.New(`meter`, content...)} }
+
+var _ tag.Interface = MeterElement{}
+
+// Value returns a copy of the element with the "value" attribute set to a number.
+func (e MeterElement) Value(value float64) MeterElement {
+	return MeterElement{e.Interface.Set(`value`, value)}
+}
+
+// Min returns a copy of the element with the "min" attribute set to a number.
+func (e MeterElement) Min(value float64) MeterElement {
+	return MeterElement{e.Interface.Set(`min`, value)}
+}
+
+// Max returns a copy of the element with the "max" attribute set to a number.
+func (e MeterElement) Max(value float64) MeterElement {
+	return MeterElement{e.Interface.Set(`max`, value)}
+}
+
+// Low returns a copy of the element with the "low" attribute set to a number.
+func (e MeterElement) Low(value float64) MeterElement {
+	return MeterElement{e.Interface.Set(`low`, value)}
+}
+
+// High returns a copy of the element with the "high" attribute set to a number.
+func (e MeterElement) High(value float64) MeterElement {
+	return MeterElement{e.Interface.Set(`high`, value)}
+}
+
+// Optimum returns a copy of the element with the "optimum" attribute set to a number.
+func (e MeterElement) Optimum(value float64) MeterElement {
+	return MeterElement{e.Interface.Set(`optimum`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e MeterElement) AccessKey(value string) MeterElement {
+	return MeterElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e MeterElement) AutoFocus() MeterElement { return MeterElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e MeterElement) ContentEditable(value string) MeterElement {
+	return MeterElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e MeterElement) Dir(value string) MeterElement {
+	return MeterElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e MeterElement) Draggable(value string) MeterElement {
+	return MeterElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e MeterElement) Hidden() MeterElement { return MeterElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e MeterElement) Inert() MeterElement { return MeterElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e MeterElement) Lang(value string) MeterElement {
+	return MeterElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e MeterElement) Popover(value string) MeterElement {
+	return MeterElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e MeterElement) Role(value string) MeterElement {
+	return MeterElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e MeterElement) Spellcheck(value string) MeterElement {
+	return MeterElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e MeterElement) TabIndex(value int) MeterElement {
+	return MeterElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e MeterElement) Title(value string) MeterElement {
+	return MeterElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e MeterElement) Translate(value string) MeterElement {
+	return MeterElement{e.Interface.Set(`translate`, value)}
+}
+
+// NavElement is the <nav> element, built on tag.Interface.
+type NavElement struct{ tag.Interface }
+
+// Nav returns a new <nav> element with the provided content.
+func Nav(content ...html.Content) NavElement { return NavElement{tag.New(`nav`, content...)} }
+
+var _ tag.Interface = NavElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e NavElement) AccessKey(value string) NavElement {
+	return NavElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e NavElement) AutoFocus() NavElement { return NavElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e NavElement) ContentEditable(value string) NavElement {
+	return NavElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e NavElement) Dir(value string) NavElement { return NavElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e NavElement) Draggable(value string) NavElement {
+	return NavElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e NavElement) Hidden() NavElement { return NavElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e NavElement) Inert() NavElement { return NavElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e NavElement) Lang(value string) NavElement { return NavElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e NavElement) Popover(value string) NavElement {
+	return NavElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e NavElement) Role(value string) NavElement { return NavElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e NavElement) Spellcheck(value string) NavElement {
+	return NavElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e NavElement) TabIndex(value int) NavElement {
+	return NavElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e NavElement) Title(value string) NavElement {
+	return NavElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e NavElement) Translate(value string) NavElement {
+	return NavElement{e.Interface.Set(`translate`, value)}
+}
+
+// NoscriptElement is the <noscript> element, built on tag.Interface.
+type NoscriptElement struct{ tag.Interface }
+
+// Noscript returns a new <noscript> element with the provided content.
+func Noscript(content ...html.Content) NoscriptElement {
+	return NoscriptElement{tag.New(`noscript`, content...)}
+}
+
+var _ tag.Interface = NoscriptElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e NoscriptElement) AccessKey(value string) NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e NoscriptElement) AutoFocus() NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e NoscriptElement) ContentEditable(value string) NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e NoscriptElement) Dir(value string) NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e NoscriptElement) Draggable(value string) NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e NoscriptElement) Hidden() NoscriptElement { return NoscriptElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e NoscriptElement) Inert() NoscriptElement { return NoscriptElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e NoscriptElement) Lang(value string) NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e NoscriptElement) Popover(value string) NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e NoscriptElement) Role(value string) NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e NoscriptElement) Spellcheck(value string) NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e NoscriptElement) TabIndex(value int) NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e NoscriptElement) Title(value string) NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e NoscriptElement) Translate(value string) NoscriptElement {
+	return NoscriptElement{e.Interface.Set(`translate`, value)}
+}
+
+// ObjectElement is the <object> element, built on tag.Interface.
+type ObjectElement struct{ tag.Interface }
+
+// Object returns a new <object> element with the provided content.
+func Object(content ...html.Content) ObjectElement {
+	return ObjectElement{tag.New(`object`, content...)}
+}
+
+var _ tag.Interface = ObjectElement{}
+
+// DataURL returns a copy of the element with the "data" attribute set to a value.
+func (e ObjectElement) DataURL(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`data`, value)}
+}
+
+// Type returns a copy of the element with the "type" attribute set to a value.
+func (e ObjectElement) Type(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`type`, value)}
+}
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e ObjectElement) Name(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`name`, value)}
+}
+
+// Form returns a copy of the element with the "form" attribute set to a value.
+func (e ObjectElement) Form(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`form`, value)}
+}
+
+// Width returns a copy of the element with the "width" attribute set to an integer.
+func (e ObjectElement) Width(value int) ObjectElement {
+	return ObjectElement{e.Interface.Set(`width`, value)}
+}
+
+// Height returns a copy of the element with the "height" attribute set to an integer.
+func (e ObjectElement) Height(value int) ObjectElement {
+	return ObjectElement{e.Interface.Set(`height`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e ObjectElement) AccessKey(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e ObjectElement) AutoFocus() ObjectElement { return ObjectElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e ObjectElement) ContentEditable(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e ObjectElement) Dir(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e ObjectElement) Draggable(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e ObjectElement) Hidden() ObjectElement { return ObjectElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e ObjectElement) Inert() ObjectElement { return ObjectElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e ObjectElement) Lang(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e ObjectElement) Popover(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e ObjectElement) Role(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e ObjectElement) Spellcheck(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e ObjectElement) TabIndex(value int) ObjectElement {
+	return ObjectElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e ObjectElement) Title(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e ObjectElement) Translate(value string) ObjectElement {
+	return ObjectElement{e.Interface.Set(`translate`, value)}
+}
+
+// OlElement is the <ol> element, built on tag.Interface.
+type OlElement struct{ tag.Interface }
+
+// Ol returns a new <ol> element with the provided content.
+func Ol(content ...html.Content) OlElement { return OlElement{tag.New(`ol`, content...)} }
+
+var _ tag.Interface = OlElement{}
+
+// Reversed returns a copy of the element with the boolean "reversed" attribute.
+func (e OlElement) Reversed() OlElement { return OlElement{e.Interface.Set(`reversed`)} }
+
+// Start returns a copy of the element with the "start" attribute set to an integer.
+func (e OlElement) Start(value int) OlElement { return OlElement{e.Interface.Set(`start`, value)} }
+
+// Type returns a copy of the element with the "type" attribute set to a value.
+func (e OlElement) Type(value string) OlElement { return OlElement{e.Interface.Set(`type`, value)} }
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e OlElement) AccessKey(value string) OlElement {
+	return OlElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e OlElement) AutoFocus() OlElement { return OlElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e OlElement) ContentEditable(value string) OlElement {
+	return OlElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e OlElement) Dir(value string) OlElement { return OlElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e OlElement) Draggable(value string) OlElement {
+	return OlElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e OlElement) Hidden() OlElement { return OlElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e OlElement) Inert() OlElement { return OlElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e OlElement) Lang(value string) OlElement { return OlElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e OlElement) Popover(value string) OlElement {
+	return OlElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e OlElement) Role(value string) OlElement { return OlElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e OlElement) Spellcheck(value string) OlElement {
+	return OlElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e OlElement) TabIndex(value int) OlElement {
+	return OlElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e OlElement) Title(value string) OlElement { return OlElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e OlElement) Translate(value string) OlElement {
+	return OlElement{e.Interface.Set(`translate`, value)}
+}
+
+// OptgroupElement is the <optgroup> element, built on tag.Interface.
+type OptgroupElement struct{ tag.Interface }
+
+// Optgroup returns a new <optgroup> element with the provided content.
+func Optgroup(content ...html.Content) OptgroupElement {
+	return OptgroupElement{tag.New(`optgroup`, content...)}
+}
+
+var _ tag.Interface = OptgroupElement{}
+
+// Disabled returns a copy of the element with the boolean "disabled" attribute.
+func (e OptgroupElement) Disabled() OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`disabled`)}
+}
+
+// Label returns a copy of the element with the "label" attribute set to a value.
+func (e OptgroupElement) Label(value string) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`label`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e OptgroupElement) AccessKey(value string) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e OptgroupElement) AutoFocus() OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e OptgroupElement) ContentEditable(value string) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e OptgroupElement) Dir(value string) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e OptgroupElement) Draggable(value string) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e OptgroupElement) Hidden() OptgroupElement { return OptgroupElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e OptgroupElement) Inert() OptgroupElement { return OptgroupElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e OptgroupElement) Lang(value string) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e OptgroupElement) Popover(value string) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e OptgroupElement) Role(value string) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e OptgroupElement) Spellcheck(value string) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e OptgroupElement) TabIndex(value int) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e OptgroupElement) Title(value string) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e OptgroupElement) Translate(value string) OptgroupElement {
+	return OptgroupElement{e.Interface.Set(`translate`, value)}
+}
+
+// OptionElement is the <option> element, built on tag.Interface.
+type OptionElement struct{ tag.Interface }
+
+// Option returns a new <option> element with the provided content.
+func Option(content ...html.Content) OptionElement {
+	return OptionElement{tag.New(`option`, content...)}
+}
+
+var _ tag.Interface = OptionElement{}
+
+// Disabled returns a copy of the element with the boolean "disabled" attribute.
+func (e OptionElement) Disabled() OptionElement { return OptionElement{e.Interface.Set(`disabled`)} }
+
+// Label returns a copy of the element with the "label" attribute set to a value.
+func (e OptionElement) Label(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`label`, value)}
+}
+
+// Selected returns a copy of the element with the boolean "selected" attribute.
+func (e OptionElement) Selected() OptionElement { return OptionElement{e.Interface.Set(`selected`)} }
+
+// Value returns a copy of the element with the "value" attribute set to a value.
+func (e OptionElement) Value(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`value`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e OptionElement) AccessKey(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e OptionElement) AutoFocus() OptionElement { return OptionElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e OptionElement) ContentEditable(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e OptionElement) Dir(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e OptionElement) Draggable(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e OptionElement) Hidden() OptionElement { return OptionElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e OptionElement) Inert() OptionElement { return OptionElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e OptionElement) Lang(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e OptionElement) Popover(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e OptionElement) Role(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e OptionElement) Spellcheck(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e OptionElement) TabIndex(value int) OptionElement {
+	return OptionElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e OptionElement) Title(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e OptionElement) Translate(value string) OptionElement {
+	return OptionElement{e.Interface.Set(`translate`, value)}
+}
+
+// OutputElement is the <output> element, built on tag.Interface.
+type OutputElement struct{ tag.Interface }
+
+// Output returns a new <output> element with the provided content.
+func Output(content ...html.Content) OutputElement {
+	return OutputElement{tag.New(`output`, content...)}
+}
+
+var _ tag.Interface = OutputElement{}
+
+// For returns a copy of the element with the "for" attribute set to a value.
+func (e OutputElement) For(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`for`, value)}
+}
+
+// Form returns a copy of the element with the "form" attribute set to a value.
+func (e OutputElement) Form(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`form`, value)}
+}
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e OutputElement) Name(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`name`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e OutputElement) AccessKey(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e OutputElement) AutoFocus() OutputElement { return OutputElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e OutputElement) ContentEditable(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e OutputElement) Dir(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e OutputElement) Draggable(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e OutputElement) Hidden() OutputElement { return OutputElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e OutputElement) Inert() OutputElement { return OutputElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e OutputElement) Lang(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e OutputElement) Popover(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e OutputElement) Role(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e OutputElement) Spellcheck(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e OutputElement) TabIndex(value int) OutputElement {
+	return OutputElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e OutputElement) Title(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e OutputElement) Translate(value string) OutputElement {
+	return OutputElement{e.Interface.Set(`translate`, value)}
+}
+
+// PElement is the <p> element, built on tag.Interface.
+type PElement struct{ tag.Interface }
+
+// P returns a new <p> element with the provided content.
+func P(content ...html.Content) PElement { return PElement{tag.New(`p`, content...)} }
+
+var _ tag.Interface = PElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e PElement) AccessKey(value string) PElement {
+	return PElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e PElement) AutoFocus() PElement { return PElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e PElement) ContentEditable(value string) PElement {
+	return PElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e PElement) Dir(value string) PElement { return PElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e PElement) Draggable(value string) PElement {
+	return PElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e PElement) Hidden() PElement { return PElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e PElement) Inert() PElement { return PElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e PElement) Lang(value string) PElement { return PElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e PElement) Popover(value string) PElement { return PElement{e.Interface.Set(`popover`, value)} }
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e PElement) Role(value string) PElement { return PElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e PElement) Spellcheck(value string) PElement {
+	return PElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e PElement) TabIndex(value int) PElement { return PElement{e.Interface.Set(`tabindex`, value)} }
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e PElement) Title(value string) PElement { return PElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e PElement) Translate(value string) PElement {
+	return PElement{e.Interface.Set(`translate`, value)}
+}
+
+// PictureElement is the <picture> element, built on tag.Interface.
+type PictureElement struct{ tag.Interface }
+
+// Picture returns a new <picture> element with the provided content.
+func Picture(content ...html.Content) PictureElement {
+	return PictureElement{tag.New(`picture`, content...)}
+}
+
+var _ tag.Interface = PictureElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e PictureElement) AccessKey(value string) PictureElement {
+	return PictureElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e PictureElement) AutoFocus() PictureElement {
+	return PictureElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e PictureElement) ContentEditable(value string) PictureElement {
+	return PictureElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e PictureElement) Dir(value string) PictureElement {
+	return PictureElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e PictureElement) Draggable(value string) PictureElement {
+	return PictureElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e PictureElement) Hidden() PictureElement { return PictureElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e PictureElement) Inert() PictureElement { return PictureElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e PictureElement) Lang(value string) PictureElement {
+	return PictureElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e PictureElement) Popover(value string) PictureElement {
+	return PictureElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e PictureElement) Role(value string) PictureElement {
+	return PictureElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e PictureElement) Spellcheck(value string) PictureElement {
+	return PictureElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e PictureElement) TabIndex(value int) PictureElement {
+	return PictureElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e PictureElement) Title(value string) PictureElement {
+	return PictureElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e PictureElement) Translate(value string) PictureElement {
+	return PictureElement{e.Interface.Set(`translate`, value)}
+}
+
+// PreElement is the <pre> element, built on tag.Interface.
+type PreElement struct{ tag.Interface }
+
+// Pre returns a new <pre> element with the provided content.
+func Pre(content ...html.Content) PreElement { return PreElement{tag.New(`pre`, content...)} }
+
+var _ tag.Interface = PreElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e PreElement) AccessKey(value string) PreElement {
+	return PreElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e PreElement) AutoFocus() PreElement { return PreElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e PreElement) ContentEditable(value string) PreElement {
+	return PreElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e PreElement) Dir(value string) PreElement { return PreElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e PreElement) Draggable(value string) PreElement {
+	return PreElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e PreElement) Hidden() PreElement { return PreElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e PreElement) Inert() PreElement { return PreElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e PreElement) Lang(value string) PreElement { return PreElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e PreElement) Popover(value string) PreElement {
+	return PreElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e PreElement) Role(value string) PreElement { return PreElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e PreElement) Spellcheck(value string) PreElement {
+	return PreElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e PreElement) TabIndex(value int) PreElement {
+	return PreElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e PreElement) Title(value string) PreElement {
+	return PreElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e PreElement) Translate(value string) PreElement {
+	return PreElement{e.Interface.Set(`translate`, value)}
+}
+
+// ProgressElement is the <progress> element, built on tag.Interface.
+type ProgressElement struct{ tag.Interface }
+
+// Progress returns a new <progress> element with the provided content.
+func Progress(content ...html.Content) ProgressElement {
+	return ProgressElement{tag.New(`progress`, content...)}
+}
+
+var _ tag.Interface = ProgressElement{}
+
+// Value returns a copy of the element with the "value" attribute set to a number.
+func (e ProgressElement) Value(value float64) ProgressElement {
+	return ProgressElement{e.Interface.Set(`value`, value)}
+}
+
+// Max returns a copy of the element with the "max" attribute set to a number.
+func (e ProgressElement) Max(value float64) ProgressElement {
+	return ProgressElement{e.Interface.Set(`max`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e ProgressElement) AccessKey(value string) ProgressElement {
+	return ProgressElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e ProgressElement) AutoFocus() ProgressElement {
+	return ProgressElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e ProgressElement) ContentEditable(value string) ProgressElement {
+	return ProgressElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e ProgressElement) Dir(value string) ProgressElement {
+	return ProgressElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e ProgressElement) Draggable(value string) ProgressElement {
+	return ProgressElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e ProgressElement) Hidden() ProgressElement { return ProgressElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e ProgressElement) Inert() ProgressElement { return ProgressElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e ProgressElement) Lang(value string) ProgressElement {
+	return ProgressElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e ProgressElement) Popover(value string) ProgressElement {
+	return ProgressElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e ProgressElement) Role(value string) ProgressElement {
+	return ProgressElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e ProgressElement) Spellcheck(value string) ProgressElement {
+	return ProgressElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e ProgressElement) TabIndex(value int) ProgressElement {
+	return ProgressElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e ProgressElement) Title(value string) ProgressElement {
+	return ProgressElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e ProgressElement) Translate(value string) ProgressElement {
+	return ProgressElement{e.Interface.Set(`translate`, value)}
+}
+
+// QElement is the <q> element, built on tag.Interface.
+type QElement struct{ tag.Interface }
+
+// Q returns a new <q> element with the provided content.
+func Q(content ...html.Content) QElement { return QElement{tag.New(`q`, content...)} }
+
+var _ tag.Interface = QElement{}
+
+// Cite returns a copy of the element with the "cite" attribute set to a value.
+func (e QElement) Cite(value string) QElement { return QElement{e.Interface.Set(`cite`, value)} }
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e QElement) AccessKey(value string) QElement {
+	return QElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e QElement) AutoFocus() QElement { return QElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e QElement) ContentEditable(value string) QElement {
+	return QElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e QElement) Dir(value string) QElement { return QElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e QElement) Draggable(value string) QElement {
+	return QElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e QElement) Hidden() QElement { return QElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e QElement) Inert() QElement { return QElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e QElement) Lang(value string) QElement { return QElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e QElement) Popover(value string) QElement { return QElement{e.Interface.Set(`popover`, value)} }
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e QElement) Role(value string) QElement { return QElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e QElement) Spellcheck(value string) QElement {
+	return QElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e QElement) TabIndex(value int) QElement { return QElement{e.Interface.Set(`tabindex`, value)} }
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e QElement) Title(value string) QElement { return QElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e QElement) Translate(value string) QElement {
+	return QElement{e.Interface.Set(`translate`, value)}
+}
+
+// RpElement is the <rp> element, built on tag.Interface.
+type RpElement struct{ tag.Interface }
+
+// Rp returns a new <rp> element with the provided content.
+func Rp(content ...html.Content) RpElement { return RpElement{tag.New(`rp`, content...)} }
+
+var _ tag.Interface = RpElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e RpElement) AccessKey(value string) RpElement {
+	return RpElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e RpElement) AutoFocus() RpElement { return RpElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e RpElement) ContentEditable(value string) RpElement {
+	return RpElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e RpElement) Dir(value string) RpElement { return RpElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e RpElement) Draggable(value string) RpElement {
+	return RpElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e RpElement) Hidden() RpElement { return RpElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e RpElement) Inert() RpElement { return RpElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e RpElement) Lang(value string) RpElement { return RpElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e RpElement) Popover(value string) RpElement {
+	return RpElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e RpElement) Role(value string) RpElement { return RpElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e RpElement) Spellcheck(value string) RpElement {
+	return RpElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e RpElement) TabIndex(value int) RpElement {
+	return RpElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e RpElement) Title(value string) RpElement { return RpElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e RpElement) Translate(value string) RpElement {
+	return RpElement{e.Interface.Set(`translate`, value)}
+}
+
+// RtElement is the <rt> element, built on tag.Interface.
+type RtElement struct{ tag.Interface }
+
+// Rt returns a new <rt> element with the provided content.
+func Rt(content ...html.Content) RtElement { return RtElement{tag.New(`rt`, content...)} }
+
+var _ tag.Interface = RtElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e RtElement) AccessKey(value string) RtElement {
+	return RtElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e RtElement) AutoFocus() RtElement { return RtElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e RtElement) ContentEditable(value string) RtElement {
+	return RtElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e RtElement) Dir(value string) RtElement { return RtElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e RtElement) Draggable(value string) RtElement {
+	return RtElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e RtElement) Hidden() RtElement { return RtElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e RtElement) Inert() RtElement { return RtElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e RtElement) Lang(value string) RtElement { return RtElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e RtElement) Popover(value string) RtElement {
+	return RtElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e RtElement) Role(value string) RtElement { return RtElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e RtElement) Spellcheck(value string) RtElement {
+	return RtElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e RtElement) TabIndex(value int) RtElement {
+	return RtElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e RtElement) Title(value string) RtElement { return RtElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e RtElement) Translate(value string) RtElement {
+	return RtElement{e.Interface.Set(`translate`, value)}
+}
+
+// RubyElement is the <ruby> element, built on tag.Interface.
+type RubyElement struct{ tag.Interface }
+
+// Ruby returns a new <ruby> element with the provided content.
+func Ruby(content ...html.Content) RubyElement { return RubyElement{tag.New(`ruby`, content...)} }
+
+var _ tag.Interface = RubyElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e RubyElement) AccessKey(value string) RubyElement {
+	return RubyElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e RubyElement) AutoFocus() RubyElement { return RubyElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e RubyElement) ContentEditable(value string) RubyElement {
+	return RubyElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e RubyElement) Dir(value string) RubyElement { return RubyElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e RubyElement) Draggable(value string) RubyElement {
+	return RubyElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e RubyElement) Hidden() RubyElement { return RubyElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e RubyElement) Inert() RubyElement { return RubyElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e RubyElement) Lang(value string) RubyElement {
+	return RubyElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e RubyElement) Popover(value string) RubyElement {
+	return RubyElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e RubyElement) Role(value string) RubyElement {
+	return RubyElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e RubyElement) Spellcheck(value string) RubyElement {
+	return RubyElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e RubyElement) TabIndex(value int) RubyElement {
+	return RubyElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e RubyElement) Title(value string) RubyElement {
+	return RubyElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e RubyElement) Translate(value string) RubyElement {
+	return RubyElement{e.Interface.Set(`translate`, value)}
+}
+
+// SElement is the <s> element, built on tag.Interface.
+type SElement struct{ tag.Interface }
+
+// S returns a new <s> element with the provided content.
+func S(content ...html.Content) SElement { return SElement{tag.New(`s`, content...)} }
+
+var _ tag.Interface = SElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SElement) AccessKey(value string) SElement {
+	return SElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SElement) AutoFocus() SElement { return SElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SElement) ContentEditable(value string) SElement {
+	return SElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SElement) Dir(value string) SElement { return SElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SElement) Draggable(value string) SElement {
+	return SElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SElement) Hidden() SElement { return SElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SElement) Inert() SElement { return SElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SElement) Lang(value string) SElement { return SElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SElement) Popover(value string) SElement { return SElement{e.Interface.Set(`popover`, value)} }
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SElement) Role(value string) SElement { return SElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SElement) Spellcheck(value string) SElement {
+	return SElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SElement) TabIndex(value int) SElement { return SElement{e.Interface.Set(`tabindex`, value)} }
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SElement) Title(value string) SElement { return SElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SElement) Translate(value string) SElement {
+	return SElement{e.Interface.Set(`translate`, value)}
+}
+
+// SampElement is the <samp> element, built on tag.Interface.
+type SampElement struct{ tag.Interface }
+
+// Samp returns a new <samp> element with the provided content.
+func Samp(content ...html.Content) SampElement { return SampElement{tag.New(`samp`, content...)} }
+
+var _ tag.Interface = SampElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SampElement) AccessKey(value string) SampElement {
+	return SampElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SampElement) AutoFocus() SampElement { return SampElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SampElement) ContentEditable(value string) SampElement {
+	return SampElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SampElement) Dir(value string) SampElement { return SampElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SampElement) Draggable(value string) SampElement {
+	return SampElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SampElement) Hidden() SampElement { return SampElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SampElement) Inert() SampElement { return SampElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SampElement) Lang(value string) SampElement {
+	return SampElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SampElement) Popover(value string) SampElement {
+	return SampElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SampElement) Role(value string) SampElement {
+	return SampElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SampElement) Spellcheck(value string) SampElement {
+	return SampElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SampElement) TabIndex(value int) SampElement {
+	return SampElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SampElement) Title(value string) SampElement {
+	return SampElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SampElement) Translate(value string) SampElement {
+	return SampElement{e.Interface.Set(`translate`, value)}
+}
+
+// ScriptElement is the <script> element, built on tag.Interface.
+type ScriptElement struct{ tag.Interface }
+
+// Script returns a new <script> element with the provided content.
+func Script(content ...html.Content) ScriptElement {
+	return ScriptElement{tag.New(`script`, content...)}
+}
+
+var _ tag.Interface = ScriptElement{}
+
+// Src returns a copy of the element with the "src" attribute set to a value.
+func (e ScriptElement) Src(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`src`, value)}
+}
+
+// Type returns a copy of the element with the "type" attribute set to a value.
+func (e ScriptElement) Type(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`type`, value)}
+}
+
+// NoModule returns a copy of the element with the boolean "nomodule" attribute.
+func (e ScriptElement) NoModule() ScriptElement { return ScriptElement{e.Interface.Set(`nomodule`)} }
+
+// Async returns a copy of the element with the boolean "async" attribute.
+func (e ScriptElement) Async() ScriptElement { return ScriptElement{e.Interface.Set(`async`)} }
+
+// DeferAttr returns a copy of the element with the boolean "defer" attribute.
+func (e ScriptElement) DeferAttr() ScriptElement { return ScriptElement{e.Interface.Set(`defer`)} }
+
+// CrossOrigin returns a copy of the element with the "crossorigin" attribute set to a value.
+func (e ScriptElement) CrossOrigin(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`crossorigin`, value)}
+}
+
+// Integrity returns a copy of the element with the "integrity" attribute set to a value.
+func (e ScriptElement) Integrity(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`integrity`, value)}
+}
+
+// ReferrerPolicy returns a copy of the element with the "referrerpolicy" attribute set to a value.
+func (e ScriptElement) ReferrerPolicy(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`referrerpolicy`, value)}
+}
+
+// Blocking returns a copy of the element with the "blocking" attribute set to a value.
+func (e ScriptElement) Blocking(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`blocking`, value)}
+}
+
+// FetchPriority returns a copy of the element with the "fetchpriority" attribute set to a value.
+func (e ScriptElement) FetchPriority(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`fetchpriority`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e ScriptElement) AccessKey(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e ScriptElement) AutoFocus() ScriptElement { return ScriptElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e ScriptElement) ContentEditable(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e ScriptElement) Dir(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e ScriptElement) Draggable(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e ScriptElement) Hidden() ScriptElement { return ScriptElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e ScriptElement) Inert() ScriptElement { return ScriptElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e ScriptElement) Lang(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e ScriptElement) Popover(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e ScriptElement) Role(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e ScriptElement) Spellcheck(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e ScriptElement) TabIndex(value int) ScriptElement {
+	return ScriptElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e ScriptElement) Title(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e ScriptElement) Translate(value string) ScriptElement {
+	return ScriptElement{e.Interface.Set(`translate`, value)}
+}
+
+// SearchElement is the <search> element, built on tag.Interface.
+type SearchElement struct{ tag.Interface }
+
+// Search returns a new <search> element with the provided content.
+func Search(content ...html.Content) SearchElement {
+	return SearchElement{tag.New(`search`, content...)}
+}
+
+var _ tag.Interface = SearchElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SearchElement) AccessKey(value string) SearchElement {
+	return SearchElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SearchElement) AutoFocus() SearchElement { return SearchElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SearchElement) ContentEditable(value string) SearchElement {
+	return SearchElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SearchElement) Dir(value string) SearchElement {
+	return SearchElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SearchElement) Draggable(value string) SearchElement {
+	return SearchElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SearchElement) Hidden() SearchElement { return SearchElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SearchElement) Inert() SearchElement { return SearchElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SearchElement) Lang(value string) SearchElement {
+	return SearchElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SearchElement) Popover(value string) SearchElement {
+	return SearchElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SearchElement) Role(value string) SearchElement {
+	return SearchElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SearchElement) Spellcheck(value string) SearchElement {
+	return SearchElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SearchElement) TabIndex(value int) SearchElement {
+	return SearchElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SearchElement) Title(value string) SearchElement {
+	return SearchElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SearchElement) Translate(value string) SearchElement {
+	return SearchElement{e.Interface.Set(`translate`, value)}
+}
+
+// SectionElement is the <section> element, built on tag.Interface.
+type SectionElement struct{ tag.Interface }
+
+// Section returns a new <section> element with the provided content.
+func Section(content ...html.Content) SectionElement {
+	return SectionElement{tag.New(`section`, content...)}
+}
+
+var _ tag.Interface = SectionElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SectionElement) AccessKey(value string) SectionElement {
+	return SectionElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SectionElement) AutoFocus() SectionElement {
+	return SectionElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SectionElement) ContentEditable(value string) SectionElement {
+	return SectionElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SectionElement) Dir(value string) SectionElement {
+	return SectionElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SectionElement) Draggable(value string) SectionElement {
+	return SectionElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SectionElement) Hidden() SectionElement { return SectionElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SectionElement) Inert() SectionElement { return SectionElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SectionElement) Lang(value string) SectionElement {
+	return SectionElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SectionElement) Popover(value string) SectionElement {
+	return SectionElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SectionElement) Role(value string) SectionElement {
+	return SectionElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SectionElement) Spellcheck(value string) SectionElement {
+	return SectionElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SectionElement) TabIndex(value int) SectionElement {
+	return SectionElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SectionElement) Title(value string) SectionElement {
+	return SectionElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SectionElement) Translate(value string) SectionElement {
+	return SectionElement{e.Interface.Set(`translate`, value)}
+}
+
+// SelectElement is the <select> element, built on tag.Interface.
+type SelectElement struct{ tag.Interface }
+
+// Select returns a new <select> element with the provided content.
+func Select(content ...html.Content) SelectElement {
+	return SelectElement{tag.New(`select`, content...)}
+}
+
+var _ tag.Interface = SelectElement{}
+
+// AutoComplete returns a copy of the element with the "autocomplete" attribute set to a value.
+func (e SelectElement) AutoComplete(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`autocomplete`, value)}
+}
+
+// Disabled returns a copy of the element with the boolean "disabled" attribute.
+func (e SelectElement) Disabled() SelectElement { return SelectElement{e.Interface.Set(`disabled`)} }
+
+// Form returns a copy of the element with the "form" attribute set to a value.
+func (e SelectElement) Form(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`form`, value)}
+}
+
+// Multiple returns a copy of the element with the boolean "multiple" attribute.
+func (e SelectElement) Multiple() SelectElement { return SelectElement{e.Interface.Set(`multiple`)} }
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e SelectElement) Name(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`name`, value)}
+}
+
+// Required returns a copy of the element with the boolean "required" attribute.
+func (e SelectElement) Required() SelectElement { return SelectElement{e.Interface.Set(`required`)} }
+
+// Size returns a copy of the element with the "size" attribute set to an integer.
+func (e SelectElement) Size(value int) SelectElement {
+	return SelectElement{e.Interface.Set(`size`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SelectElement) AccessKey(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SelectElement) AutoFocus() SelectElement { return SelectElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SelectElement) ContentEditable(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SelectElement) Dir(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SelectElement) Draggable(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SelectElement) Hidden() SelectElement { return SelectElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SelectElement) Inert() SelectElement { return SelectElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SelectElement) Lang(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SelectElement) Popover(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SelectElement) Role(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SelectElement) Spellcheck(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SelectElement) TabIndex(value int) SelectElement {
+	return SelectElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SelectElement) Title(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SelectElement) Translate(value string) SelectElement {
+	return SelectElement{e.Interface.Set(`translate`, value)}
+}
+
+// SlotElement is the <slot> element, built on tag.Interface.
+type SlotElement struct{ tag.Interface }
+
+// Slot returns a new <slot> element with the provided content.
+func Slot(content ...html.Content) SlotElement { return SlotElement{tag.New(`slot`, content...)} }
+
+var _ tag.Interface = SlotElement{}
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e SlotElement) Name(value string) SlotElement {
+	return SlotElement{e.Interface.Set(`name`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SlotElement) AccessKey(value string) SlotElement {
+	return SlotElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SlotElement) AutoFocus() SlotElement { return SlotElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SlotElement) ContentEditable(value string) SlotElement {
+	return SlotElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SlotElement) Dir(value string) SlotElement { return SlotElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SlotElement) Draggable(value string) SlotElement {
+	return SlotElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SlotElement) Hidden() SlotElement { return SlotElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SlotElement) Inert() SlotElement { return SlotElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SlotElement) Lang(value string) SlotElement {
+	return SlotElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SlotElement) Popover(value string) SlotElement {
+	return SlotElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SlotElement) Role(value string) SlotElement {
+	return SlotElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SlotElement) Spellcheck(value string) SlotElement {
+	return SlotElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SlotElement) TabIndex(value int) SlotElement {
+	return SlotElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SlotElement) Title(value string) SlotElement {
+	return SlotElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SlotElement) Translate(value string) SlotElement {
+	return SlotElement{e.Interface.Set(`translate`, value)}
+}
+
+// SmallElement is the <small> element, built on tag.Interface.
+type SmallElement struct{ tag.Interface }
+
+// Small returns a new <small> element with the provided content.
+func Small(content ...html.Content) SmallElement { return SmallElement{tag.New(`small`, content...)} }
+
+var _ tag.Interface = SmallElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SmallElement) AccessKey(value string) SmallElement {
+	return SmallElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SmallElement) AutoFocus() SmallElement { return SmallElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SmallElement) ContentEditable(value string) SmallElement {
+	return SmallElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SmallElement) Dir(value string) SmallElement {
+	return SmallElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SmallElement) Draggable(value string) SmallElement {
+	return SmallElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SmallElement) Hidden() SmallElement { return SmallElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SmallElement) Inert() SmallElement { return SmallElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SmallElement) Lang(value string) SmallElement {
+	return SmallElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SmallElement) Popover(value string) SmallElement {
+	return SmallElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SmallElement) Role(value string) SmallElement {
+	return SmallElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SmallElement) Spellcheck(value string) SmallElement {
+	return SmallElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SmallElement) TabIndex(value int) SmallElement {
+	return SmallElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SmallElement) Title(value string) SmallElement {
+	return SmallElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SmallElement) Translate(value string) SmallElement {
+	return SmallElement{e.Interface.Set(`translate`, value)}
+}
+
+// SourceElement is the <source> element, built on tag.Interface.
+type SourceElement struct{ tag.Interface }
+
+// Source returns a new <source> element, which is a void element that cannot have content.
+func Source() SourceElement { return SourceElement{tag.New(`source`)} }
+
+var _ tag.Interface = SourceElement{}
+
+// Type returns a copy of the element with the "type" attribute set to a value.
+func (e SourceElement) Type(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`type`, value)}
+}
+
+// Media returns a copy of the element with the "media" attribute set to a value.
+func (e SourceElement) Media(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`media`, value)}
+}
+
+// Src returns a copy of the element with the "src" attribute set to a value.
+func (e SourceElement) Src(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`src`, value)}
+}
+
+// SrcSet returns a copy of the element with the "srcset" attribute set to a value.
+func (e SourceElement) SrcSet(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`srcset`, value)}
+}
+
+// Sizes returns a copy of the element with the "sizes" attribute set to a value.
+func (e SourceElement) Sizes(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`sizes`, value)}
+}
+
+// Width returns a copy of the element with the "width" attribute set to an integer.
+func (e SourceElement) Width(value int) SourceElement {
+	return SourceElement{e.Interface.Set(`width`, value)}
+}
+
+// Height returns a copy of the element with the "height" attribute set to an integer.
+func (e SourceElement) Height(value int) SourceElement {
+	return SourceElement{e.Interface.Set(`height`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SourceElement) AccessKey(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SourceElement) AutoFocus() SourceElement { return SourceElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SourceElement) ContentEditable(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SourceElement) Dir(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SourceElement) Draggable(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SourceElement) Hidden() SourceElement { return SourceElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SourceElement) Inert() SourceElement { return SourceElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SourceElement) Lang(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SourceElement) Popover(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SourceElement) Role(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SourceElement) Spellcheck(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SourceElement) TabIndex(value int) SourceElement {
+	return SourceElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SourceElement) Title(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SourceElement) Translate(value string) SourceElement {
+	return SourceElement{e.Interface.Set(`translate`, value)}
+}
+
+// SpanElement is the <span> element, built on tag.Interface.
+type SpanElement struct{ tag.Interface }
+
+// Span returns a new <span> element with the provided content.
+func Span(content ...html.Content) SpanElement { return SpanElement{tag.New(`span`, content...)} }
+
+var _ tag.Interface = SpanElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SpanElement) AccessKey(value string) SpanElement {
+	return SpanElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SpanElement) AutoFocus() SpanElement { return SpanElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SpanElement) ContentEditable(value string) SpanElement {
+	return SpanElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SpanElement) Dir(value string) SpanElement { return SpanElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SpanElement) Draggable(value string) SpanElement {
+	return SpanElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SpanElement) Hidden() SpanElement { return SpanElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SpanElement) Inert() SpanElement { return SpanElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SpanElement) Lang(value string) SpanElement {
+	return SpanElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SpanElement) Popover(value string) SpanElement {
+	return SpanElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SpanElement) Role(value string) SpanElement {
+	return SpanElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SpanElement) Spellcheck(value string) SpanElement {
+	return SpanElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SpanElement) TabIndex(value int) SpanElement {
+	return SpanElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SpanElement) Title(value string) SpanElement {
+	return SpanElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SpanElement) Translate(value string) SpanElement {
+	return SpanElement{e.Interface.Set(`translate`, value)}
+}
+
+// StrongElement is the <strong> element, built on tag.Interface.
+type StrongElement struct{ tag.Interface }
+
+// Strong returns a new <strong> element with the provided content.
+func Strong(content ...html.Content) StrongElement {
+	return StrongElement{tag.New(`strong`, content...)}
+}
+
+var _ tag.Interface = StrongElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e StrongElement) AccessKey(value string) StrongElement {
+	return StrongElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e StrongElement) AutoFocus() StrongElement { return StrongElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e StrongElement) ContentEditable(value string) StrongElement {
+	return StrongElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e StrongElement) Dir(value string) StrongElement {
+	return StrongElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e StrongElement) Draggable(value string) StrongElement {
+	return StrongElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e StrongElement) Hidden() StrongElement { return StrongElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e StrongElement) Inert() StrongElement { return StrongElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e StrongElement) Lang(value string) StrongElement {
+	return StrongElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e StrongElement) Popover(value string) StrongElement {
+	return StrongElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e StrongElement) Role(value string) StrongElement {
+	return StrongElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e StrongElement) Spellcheck(value string) StrongElement {
+	return StrongElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e StrongElement) TabIndex(value int) StrongElement {
+	return StrongElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e StrongElement) Title(value string) StrongElement {
+	return StrongElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e StrongElement) Translate(value string) StrongElement {
+	return StrongElement{e.Interface.Set(`translate`, value)}
+}
+
+// StyleElement is the <style> element, built on tag.Interface.
+type StyleElement struct{ tag.Interface }
+
+// Style returns a new <style> element with the provided content.
+func Style(content ...html.Content) StyleElement { return StyleElement{tag.New(`style`, content...)} }
+
+var _ tag.Interface = StyleElement{}
+
+// Media returns a copy of the element with the "media" attribute set to a value.
+func (e StyleElement) Media(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`media`, value)}
+}
+
+// Blocking returns a copy of the element with the "blocking" attribute set to a value.
+func (e StyleElement) Blocking(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`blocking`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e StyleElement) AccessKey(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e StyleElement) AutoFocus() StyleElement { return StyleElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e StyleElement) ContentEditable(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e StyleElement) Dir(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e StyleElement) Draggable(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e StyleElement) Hidden() StyleElement { return StyleElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e StyleElement) Inert() StyleElement { return StyleElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e StyleElement) Lang(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e StyleElement) Popover(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e StyleElement) Role(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e StyleElement) Spellcheck(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e StyleElement) TabIndex(value int) StyleElement {
+	return StyleElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e StyleElement) Title(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e StyleElement) Translate(value string) StyleElement {
+	return StyleElement{e.Interface.Set(`translate`, value)}
+}
+
+// SubElement is the <sub> element, built on tag.Interface.
+type SubElement struct{ tag.Interface }
+
+// Sub returns a new <sub> element with the provided content.
+func Sub(content ...html.Content) SubElement { return SubElement{tag.New(`sub`, content...)} }
+
+var _ tag.Interface = SubElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SubElement) AccessKey(value string) SubElement {
+	return SubElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SubElement) AutoFocus() SubElement { return SubElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SubElement) ContentEditable(value string) SubElement {
+	return SubElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SubElement) Dir(value string) SubElement { return SubElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SubElement) Draggable(value string) SubElement {
+	return SubElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SubElement) Hidden() SubElement { return SubElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SubElement) Inert() SubElement { return SubElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SubElement) Lang(value string) SubElement { return SubElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SubElement) Popover(value string) SubElement {
+	return SubElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SubElement) Role(value string) SubElement { return SubElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SubElement) Spellcheck(value string) SubElement {
+	return SubElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SubElement) TabIndex(value int) SubElement {
+	return SubElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SubElement) Title(value string) SubElement {
+	return SubElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SubElement) Translate(value string) SubElement {
+	return SubElement{e.Interface.Set(`translate`, value)}
+}
+
+// SummaryElement is the <summary> element, built on tag.Interface.
+type SummaryElement struct{ tag.Interface }
+
+// Summary returns a new <summary> element with the provided content.
+func Summary(content ...html.Content) SummaryElement {
+	return SummaryElement{tag.New(`summary`, content...)}
+}
+
+var _ tag.Interface = SummaryElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SummaryElement) AccessKey(value string) SummaryElement {
+	return SummaryElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SummaryElement) AutoFocus() SummaryElement {
+	return SummaryElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SummaryElement) ContentEditable(value string) SummaryElement {
+	return SummaryElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SummaryElement) Dir(value string) SummaryElement {
+	return SummaryElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SummaryElement) Draggable(value string) SummaryElement {
+	return SummaryElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SummaryElement) Hidden() SummaryElement { return SummaryElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SummaryElement) Inert() SummaryElement { return SummaryElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SummaryElement) Lang(value string) SummaryElement {
+	return SummaryElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SummaryElement) Popover(value string) SummaryElement {
+	return SummaryElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SummaryElement) Role(value string) SummaryElement {
+	return SummaryElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SummaryElement) Spellcheck(value string) SummaryElement {
+	return SummaryElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SummaryElement) TabIndex(value int) SummaryElement {
+	return SummaryElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SummaryElement) Title(value string) SummaryElement {
+	return SummaryElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SummaryElement) Translate(value string) SummaryElement {
+	return SummaryElement{e.Interface.Set(`translate`, value)}
+}
+
+// SupElement is the <sup> element, built on tag.Interface.
+type SupElement struct{ tag.Interface }
+
+// Sup returns a new <sup> element with the provided content.
+func Sup(content ...html.Content) SupElement { return SupElement{tag.New(`sup`, content...)} }
+
+var _ tag.Interface = SupElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e SupElement) AccessKey(value string) SupElement {
+	return SupElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e SupElement) AutoFocus() SupElement { return SupElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e SupElement) ContentEditable(value string) SupElement {
+	return SupElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e SupElement) Dir(value string) SupElement { return SupElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e SupElement) Draggable(value string) SupElement {
+	return SupElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e SupElement) Hidden() SupElement { return SupElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e SupElement) Inert() SupElement { return SupElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e SupElement) Lang(value string) SupElement { return SupElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e SupElement) Popover(value string) SupElement {
+	return SupElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e SupElement) Role(value string) SupElement { return SupElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e SupElement) Spellcheck(value string) SupElement {
+	return SupElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e SupElement) TabIndex(value int) SupElement {
+	return SupElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e SupElement) Title(value string) SupElement {
+	return SupElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e SupElement) Translate(value string) SupElement {
+	return SupElement{e.Interface.Set(`translate`, value)}
+}
+
+// TableElement is the <table> element, built on tag.Interface.
+type TableElement struct{ tag.Interface }
+
+// Table returns a new <table> element with the provided content.
+func Table(content ...html.Content) TableElement { return TableElement{tag.New(`table`, content...)} }
+
+var _ tag.Interface = TableElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e TableElement) AccessKey(value string) TableElement {
+	return TableElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e TableElement) AutoFocus() TableElement { return TableElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e TableElement) ContentEditable(value string) TableElement {
+	return TableElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e TableElement) Dir(value string) TableElement {
+	return TableElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e TableElement) Draggable(value string) TableElement {
+	return TableElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e TableElement) Hidden() TableElement { return TableElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e TableElement) Inert() TableElement { return TableElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e TableElement) Lang(value string) TableElement {
+	return TableElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e TableElement) Popover(value string) TableElement {
+	return TableElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e TableElement) Role(value string) TableElement {
+	return TableElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e TableElement) Spellcheck(value string) TableElement {
+	return TableElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e TableElement) TabIndex(value int) TableElement {
+	return TableElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e TableElement) Title(value string) TableElement {
+	return TableElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e TableElement) Translate(value string) TableElement {
+	return TableElement{e.Interface.Set(`translate`, value)}
+}
+
+// TbodyElement is the <tbody> element, built on tag.Interface.
+type TbodyElement struct{ tag.Interface }
+
+// Tbody returns a new <tbody> element with the provided content.
+func Tbody(content ...html.Content) TbodyElement { return TbodyElement{tag.New(`tbody`, content...)} }
+
+var _ tag.Interface = TbodyElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e TbodyElement) AccessKey(value string) TbodyElement {
+	return TbodyElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e TbodyElement) AutoFocus() TbodyElement { return TbodyElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e TbodyElement) ContentEditable(value string) TbodyElement {
+	return TbodyElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e TbodyElement) Dir(value string) TbodyElement {
+	return TbodyElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e TbodyElement) Draggable(value string) TbodyElement {
+	return TbodyElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e TbodyElement) Hidden() TbodyElement { return TbodyElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e TbodyElement) Inert() TbodyElement { return TbodyElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e TbodyElement) Lang(value string) TbodyElement {
+	return TbodyElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e TbodyElement) Popover(value string) TbodyElement {
+	return TbodyElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e TbodyElement) Role(value string) TbodyElement {
+	return TbodyElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e TbodyElement) Spellcheck(value string) TbodyElement {
+	return TbodyElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e TbodyElement) TabIndex(value int) TbodyElement {
+	return TbodyElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e TbodyElement) Title(value string) TbodyElement {
+	return TbodyElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e TbodyElement) Translate(value string) TbodyElement {
+	return TbodyElement{e.Interface.Set(`translate`, value)}
+}
+
+// TdElement is the <td> element, built on tag.Interface.
+type TdElement struct{ tag.Interface }
+
+// Td returns a new <td> element with the provided content.
+func Td(content ...html.Content) TdElement { return TdElement{tag.New(`td`, content...)} }
+
+var _ tag.Interface = TdElement{}
+
+// ColSpan returns a copy of the element with the "colspan" attribute set to an integer.
+func (e TdElement) ColSpan(value int) TdElement { return TdElement{e.Interface.Set(`colspan`, value)} }
+
+// RowSpan returns a copy of the element with the "rowspan" attribute set to an integer.
+func (e TdElement) RowSpan(value int) TdElement { return TdElement{e.Interface.Set(`rowspan`, value)} }
+
+// Headers returns a copy of the element with the "headers" attribute set to a value.
+func (e TdElement) Headers(value string) TdElement {
+	return TdElement{e.Interface.Set(`headers`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e TdElement) AccessKey(value string) TdElement {
+	return TdElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e TdElement) AutoFocus() TdElement { return TdElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e TdElement) ContentEditable(value string) TdElement {
+	return TdElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e TdElement) Dir(value string) TdElement { return TdElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e TdElement) Draggable(value string) TdElement {
+	return TdElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e TdElement) Hidden() TdElement { return TdElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e TdElement) Inert() TdElement { return TdElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e TdElement) Lang(value string) TdElement { return TdElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e TdElement) Popover(value string) TdElement {
+	return TdElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e TdElement) Role(value string) TdElement { return TdElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e TdElement) Spellcheck(value string) TdElement {
+	return TdElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e TdElement) TabIndex(value int) TdElement {
+	return TdElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e TdElement) Title(value string) TdElement { return TdElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e TdElement) Translate(value string) TdElement {
+	return TdElement{e.Interface.Set(`translate`, value)}
+}
+
+// TemplateElement is the <template> element, built on tag.Interface.
+type TemplateElement struct{ tag.Interface }
+
+// Template returns a new <template> element with the provided content.
+func Template(content ...html.Content) TemplateElement {
+	return TemplateElement{tag.New(`template`, content...)}
+}
+
+var _ tag.Interface = TemplateElement{}
+
+// ShadowRootMode returns a copy of the element with the "shadowrootmode" attribute set to a value.
+func (e TemplateElement) ShadowRootMode(value string) TemplateElement {
+	return TemplateElement{e.Interface.Set(`shadowrootmode`, value)}
+}
+
+// ShadowRootDelegatesFocus returns a copy of the element with the boolean "shadowrootdelegatesfocus" attribute.
+func (e TemplateElement) ShadowRootDelegatesFocus() TemplateElement {
+	return TemplateElement{e.Interface.Set(`shadowrootdelegatesfocus`)}
+}
+
+// ShadowRootClonable returns a copy of the element with the boolean "shadowrootclonable" attribute.
+func (e TemplateElement) ShadowRootClonable() TemplateElement {
+	return TemplateElement{e.Interface.Set(`shadowrootclonable`)}
+}
+
+// ShadowRootSerializable returns a copy of the element with the boolean "shadowrootserializable" attribute.
+func (e TemplateElement) ShadowRootSerializable() TemplateElement {
+	return TemplateElement{e.Interface.Set(`shadowrootserializable`)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e TemplateElement) AccessKey(value string) TemplateElement {
+	return TemplateElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e TemplateElement) AutoFocus() TemplateElement {
+	return TemplateElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e TemplateElement) ContentEditable(value string) TemplateElement {
+	return TemplateElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e TemplateElement) Dir(value string) TemplateElement {
+	return TemplateElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e TemplateElement) Draggable(value string) TemplateElement {
+	return TemplateElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e TemplateElement) Hidden() TemplateElement { return TemplateElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e TemplateElement) Inert() TemplateElement { return TemplateElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e TemplateElement) Lang(value string) TemplateElement {
+	return TemplateElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e TemplateElement) Popover(value string) TemplateElement {
+	return TemplateElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e TemplateElement) Role(value string) TemplateElement {
+	return TemplateElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e TemplateElement) Spellcheck(value string) TemplateElement {
+	return TemplateElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e TemplateElement) TabIndex(value int) TemplateElement {
+	return TemplateElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e TemplateElement) Title(value string) TemplateElement {
+	return TemplateElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e TemplateElement) Translate(value string) TemplateElement {
+	return TemplateElement{e.Interface.Set(`translate`, value)}
+}
+
+// TextareaElement is the <textarea> element, built on tag.Interface.
+type TextareaElement struct{ tag.Interface }
+
+// Textarea returns a new <textarea> element with the provided content.
+func Textarea(content ...html.Content) TextareaElement {
+	return TextareaElement{tag.New(`textarea`, content...)}
+}
+
+var _ tag.Interface = TextareaElement{}
+
+// AutoComplete returns a copy of the element with the "autocomplete" attribute set to a value.
+func (e TextareaElement) AutoComplete(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`autocomplete`, value)}
+}
+
+// Cols returns a copy of the element with the "cols" attribute set to an integer.
+func (e TextareaElement) Cols(value int) TextareaElement {
+	return TextareaElement{e.Interface.Set(`cols`, value)}
+}
+
+// DirName returns a copy of the element with the "dirname" attribute set to a value.
+func (e TextareaElement) DirName(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`dirname`, value)}
+}
+
+// Disabled returns a copy of the element with the boolean "disabled" attribute.
+func (e TextareaElement) Disabled() TextareaElement {
+	return TextareaElement{e.Interface.Set(`disabled`)}
+}
+
+// Form returns a copy of the element with the "form" attribute set to a value.
+func (e TextareaElement) Form(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`form`, value)}
+}
+
+// MaxLength returns a copy of the element with the "maxlength" attribute set to an integer.
+func (e TextareaElement) MaxLength(value int) TextareaElement {
+	return TextareaElement{e.Interface.Set(`maxlength`, value)}
+}
+
+// MinLength returns a copy of the element with the "minlength" attribute set to an integer.
+func (e TextareaElement) MinLength(value int) TextareaElement {
+	return TextareaElement{e.Interface.Set(`minlength`, value)}
+}
+
+// Name returns a copy of the element with the "name" attribute set to a value.
+func (e TextareaElement) Name(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`name`, value)}
+}
+
+// Placeholder returns a copy of the element with the "placeholder" attribute set to a value.
+func (e TextareaElement) Placeholder(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`placeholder`, value)}
+}
+
+// ReadOnly returns a copy of the element with the boolean "readonly" attribute.
+func (e TextareaElement) ReadOnly() TextareaElement {
+	return TextareaElement{e.Interface.Set(`readonly`)}
+}
+
+// Required returns a copy of the element with the boolean "required" attribute.
+func (e TextareaElement) Required() TextareaElement {
+	return TextareaElement{e.Interface.Set(`required`)}
+}
+
+// Rows returns a copy of the element with the "rows" attribute set to an integer.
+func (e TextareaElement) Rows(value int) TextareaElement {
+	return TextareaElement{e.Interface.Set(`rows`, value)}
+}
+
+// Wrap returns a copy of the element with the "wrap" attribute set to a value.
+func (e TextareaElement) Wrap(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`wrap`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e TextareaElement) AccessKey(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e TextareaElement) AutoFocus() TextareaElement {
+	return TextareaElement{e.Interface.Set(`autofocus`)}
+}
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e TextareaElement) ContentEditable(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e TextareaElement) Dir(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e TextareaElement) Draggable(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e TextareaElement) Hidden() TextareaElement { return TextareaElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e TextareaElement) Inert() TextareaElement { return TextareaElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e TextareaElement) Lang(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e TextareaElement) Popover(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e TextareaElement) Role(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e TextareaElement) Spellcheck(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e TextareaElement) TabIndex(value int) TextareaElement {
+	return TextareaElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e TextareaElement) Title(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e TextareaElement) Translate(value string) TextareaElement {
+	return TextareaElement{e.Interface.Set(`translate`, value)}
+}
+
+// TfootElement is the <tfoot> element, built on tag.Interface.
+type TfootElement struct{ tag.Interface }
+
+// Tfoot returns a new <tfoot> element with the provided content.
+func Tfoot(content ...html.Content) TfootElement { return TfootElement{tag.New(`tfoot`, content...)} }
+
+var _ tag.Interface = TfootElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e TfootElement) AccessKey(value string) TfootElement {
+	return TfootElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e TfootElement) AutoFocus() TfootElement { return TfootElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e TfootElement) ContentEditable(value string) TfootElement {
+	return TfootElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e TfootElement) Dir(value string) TfootElement {
+	return TfootElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e TfootElement) Draggable(value string) TfootElement {
+	return TfootElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e TfootElement) Hidden() TfootElement { return TfootElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e TfootElement) Inert() TfootElement { return TfootElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e TfootElement) Lang(value string) TfootElement {
+	return TfootElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e TfootElement) Popover(value string) TfootElement {
+	return TfootElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e TfootElement) Role(value string) TfootElement {
+	return TfootElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e TfootElement) Spellcheck(value string) TfootElement {
+	return TfootElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e TfootElement) TabIndex(value int) TfootElement {
+	return TfootElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e TfootElement) Title(value string) TfootElement {
+	return TfootElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e TfootElement) Translate(value string) TfootElement {
+	return TfootElement{e.Interface.Set(`translate`, value)}
+}
+
+// ThElement is the <th> element, built on tag.Interface.
+type ThElement struct{ tag.Interface }
+
+// Th returns a new <th> element with the provided content.
+func Th(content ...html.Content) ThElement { return ThElement{tag.New(`th`, content...)} }
+
+var _ tag.Interface = ThElement{}
+
+// ColSpan returns a copy of the element with the "colspan" attribute set to an integer.
+func (e ThElement) ColSpan(value int) ThElement { return ThElement{e.Interface.Set(`colspan`, value)} }
+
+// RowSpan returns a copy of the element with the "rowspan" attribute set to an integer.
+func (e ThElement) RowSpan(value int) ThElement { return ThElement{e.Interface.Set(`rowspan`, value)} }
+
+// Headers returns a copy of the element with the "headers" attribute set to a value.
+func (e ThElement) Headers(value string) ThElement {
+	return ThElement{e.Interface.Set(`headers`, value)}
+}
+
+// Scope returns a copy of the element with the "scope" attribute set to a value.
+func (e ThElement) Scope(value string) ThElement { return ThElement{e.Interface.Set(`scope`, value)} }
+
+// Abbr returns a copy of the element with the "abbr" attribute set to a value.
+func (e ThElement) Abbr(value string) ThElement { return ThElement{e.Interface.Set(`abbr`, value)} }
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e ThElement) AccessKey(value string) ThElement {
+	return ThElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e ThElement) AutoFocus() ThElement { return ThElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e ThElement) ContentEditable(value string) ThElement {
+	return ThElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e ThElement) Dir(value string) ThElement { return ThElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e ThElement) Draggable(value string) ThElement {
+	return ThElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e ThElement) Hidden() ThElement { return ThElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e ThElement) Inert() ThElement { return ThElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e ThElement) Lang(value string) ThElement { return ThElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e ThElement) Popover(value string) ThElement {
+	return ThElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e ThElement) Role(value string) ThElement { return ThElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e ThElement) Spellcheck(value string) ThElement {
+	return ThElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e ThElement) TabIndex(value int) ThElement {
+	return ThElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e ThElement) Title(value string) ThElement { return ThElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e ThElement) Translate(value string) ThElement {
+	return ThElement{e.Interface.Set(`translate`, value)}
+}
+
+// TheadElement is the <thead> element, built on tag.Interface.
+type TheadElement struct{ tag.Interface }
+
+// Thead returns a new <thead> element with the provided content.
+func Thead(content ...html.Content) TheadElement { return TheadElement{tag.New(`thead`, content...)} }
+
+var _ tag.Interface = TheadElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e TheadElement) AccessKey(value string) TheadElement {
+	return TheadElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e TheadElement) AutoFocus() TheadElement { return TheadElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e TheadElement) ContentEditable(value string) TheadElement {
+	return TheadElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e TheadElement) Dir(value string) TheadElement {
+	return TheadElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e TheadElement) Draggable(value string) TheadElement {
+	return TheadElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e TheadElement) Hidden() TheadElement { return TheadElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e TheadElement) Inert() TheadElement { return TheadElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e TheadElement) Lang(value string) TheadElement {
+	return TheadElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e TheadElement) Popover(value string) TheadElement {
+	return TheadElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e TheadElement) Role(value string) TheadElement {
+	return TheadElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e TheadElement) Spellcheck(value string) TheadElement {
+	return TheadElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e TheadElement) TabIndex(value int) TheadElement {
+	return TheadElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e TheadElement) Title(value string) TheadElement {
+	return TheadElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e TheadElement) Translate(value string) TheadElement {
+	return TheadElement{e.Interface.Set(`translate`, value)}
+}
+
+// TimeElement is the <time> element, built on tag.Interface.
+type TimeElement struct{ tag.Interface }
+
+// Time returns a new <time> element with the provided content.
+func Time(content ...html.Content) TimeElement { return TimeElement{tag.New(`time`, content...)} }
+
+var _ tag.Interface = TimeElement{}
+
+// DateTime returns a copy of the element with the "datetime" attribute set to a value.
+func (e TimeElement) DateTime(value string) TimeElement {
+	return TimeElement{e.Interface.Set(`datetime`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e TimeElement) AccessKey(value string) TimeElement {
+	return TimeElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e TimeElement) AutoFocus() TimeElement { return TimeElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e TimeElement) ContentEditable(value string) TimeElement {
+	return TimeElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e TimeElement) Dir(value string) TimeElement { return TimeElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e TimeElement) Draggable(value string) TimeElement {
+	return TimeElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e TimeElement) Hidden() TimeElement { return TimeElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e TimeElement) Inert() TimeElement { return TimeElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e TimeElement) Lang(value string) TimeElement {
+	return TimeElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e TimeElement) Popover(value string) TimeElement {
+	return TimeElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e TimeElement) Role(value string) TimeElement {
+	return TimeElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e TimeElement) Spellcheck(value string) TimeElement {
+	return TimeElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e TimeElement) TabIndex(value int) TimeElement {
+	return TimeElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e TimeElement) Title(value string) TimeElement {
+	return TimeElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e TimeElement) Translate(value string) TimeElement {
+	return TimeElement{e.Interface.Set(`translate`, value)}
+}
+
+// TitleElement is the <title> element, built on tag.Interface.
+type TitleElement struct{ tag.Interface }
+
+// Title returns a new <title> element with the provided content.
+func Title(content ...html.Content) TitleElement { return TitleElement{tag.New(`title`, content...)} }
+
+var _ tag.Interface = TitleElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e TitleElement) AccessKey(value string) TitleElement {
+	return TitleElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e TitleElement) AutoFocus() TitleElement { return TitleElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e TitleElement) ContentEditable(value string) TitleElement {
+	return TitleElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e TitleElement) Dir(value string) TitleElement {
+	return TitleElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e TitleElement) Draggable(value string) TitleElement {
+	return TitleElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e TitleElement) Hidden() TitleElement { return TitleElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e TitleElement) Inert() TitleElement { return TitleElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e TitleElement) Lang(value string) TitleElement {
+	return TitleElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e TitleElement) Popover(value string) TitleElement {
+	return TitleElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e TitleElement) Role(value string) TitleElement {
+	return TitleElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e TitleElement) Spellcheck(value string) TitleElement {
+	return TitleElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e TitleElement) TabIndex(value int) TitleElement {
+	return TitleElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e TitleElement) Title(value string) TitleElement {
+	return TitleElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e TitleElement) Translate(value string) TitleElement {
+	return TitleElement{e.Interface.Set(`translate`, value)}
+}
+
+// TrElement is the <tr> element, built on tag.Interface.
+type TrElement struct{ tag.Interface }
+
+// Tr returns a new <tr> element with the provided content.
+func Tr(content ...html.Content) TrElement { return TrElement{tag.New(`tr`, content...)} }
+
+var _ tag.Interface = TrElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e TrElement) AccessKey(value string) TrElement {
+	return TrElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e TrElement) AutoFocus() TrElement { return TrElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e TrElement) ContentEditable(value string) TrElement {
+	return TrElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e TrElement) Dir(value string) TrElement { return TrElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e TrElement) Draggable(value string) TrElement {
+	return TrElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e TrElement) Hidden() TrElement { return TrElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e TrElement) Inert() TrElement { return TrElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e TrElement) Lang(value string) TrElement { return TrElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e TrElement) Popover(value string) TrElement {
+	return TrElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e TrElement) Role(value string) TrElement { return TrElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e TrElement) Spellcheck(value string) TrElement {
+	return TrElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e TrElement) TabIndex(value int) TrElement {
+	return TrElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e TrElement) Title(value string) TrElement { return TrElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e TrElement) Translate(value string) TrElement {
+	return TrElement{e.Interface.Set(`translate`, value)}
+}
+
+// TrackElement is the <track> element, built on tag.Interface.
+type TrackElement struct{ tag.Interface }
+
+// Track returns a new <track> element, which is a void element that cannot have content.
+func Track() TrackElement { return TrackElement{tag.New(`track`)} }
+
+var _ tag.Interface = TrackElement{}
+
+// Default returns a copy of the element with the boolean "default" attribute.
+func (e TrackElement) Default() TrackElement { return TrackElement{e.Interface.Set(`default`)} }
+
+// Kind returns a copy of the element with the "kind" attribute set to a value.
+func (e TrackElement) Kind(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`kind`, value)}
+}
+
+// Label returns a copy of the element with the "label" attribute set to a value.
+func (e TrackElement) Label(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`label`, value)}
+}
+
+// Src returns a copy of the element with the "src" attribute set to a value.
+func (e TrackElement) Src(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`src`, value)}
+}
+
+// SrcLang returns a copy of the element with the "srclang" attribute set to a value.
+func (e TrackElement) SrcLang(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`srclang`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e TrackElement) AccessKey(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e TrackElement) AutoFocus() TrackElement { return TrackElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e TrackElement) ContentEditable(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e TrackElement) Dir(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e TrackElement) Draggable(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e TrackElement) Hidden() TrackElement { return TrackElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e TrackElement) Inert() TrackElement { return TrackElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e TrackElement) Lang(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e TrackElement) Popover(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e TrackElement) Role(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e TrackElement) Spellcheck(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e TrackElement) TabIndex(value int) TrackElement {
+	return TrackElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e TrackElement) Title(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e TrackElement) Translate(value string) TrackElement {
+	return TrackElement{e.Interface.Set(`translate`, value)}
+}
+
+// UElement is the <u> element, built on tag.Interface.
+type UElement struct{ tag.Interface }
+
+// U returns a new <u> element with the provided content.
+func U(content ...html.Content) UElement { return UElement{tag.New(`u`, content...)} }
+
+var _ tag.Interface = UElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e UElement) AccessKey(value string) UElement {
+	return UElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e UElement) AutoFocus() UElement { return UElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e UElement) ContentEditable(value string) UElement {
+	return UElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e UElement) Dir(value string) UElement { return UElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e UElement) Draggable(value string) UElement {
+	return UElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e UElement) Hidden() UElement { return UElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e UElement) Inert() UElement { return UElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e UElement) Lang(value string) UElement { return UElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e UElement) Popover(value string) UElement { return UElement{e.Interface.Set(`popover`, value)} }
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e UElement) Role(value string) UElement { return UElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e UElement) Spellcheck(value string) UElement {
+	return UElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e UElement) TabIndex(value int) UElement { return UElement{e.Interface.Set(`tabindex`, value)} }
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e UElement) Title(value string) UElement { return UElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e UElement) Translate(value string) UElement {
+	return UElement{e.Interface.Set(`translate`, value)}
+}
+
+// UlElement is the <ul> element, built on tag.Interface.
+type UlElement struct{ tag.Interface }
+
+// Ul returns a new <ul> element with the provided content.
+func Ul(content ...html.Content) UlElement { return UlElement{tag.New(`ul`, content...)} }
+
+var _ tag.Interface = UlElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e UlElement) AccessKey(value string) UlElement {
+	return UlElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e UlElement) AutoFocus() UlElement { return UlElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e UlElement) ContentEditable(value string) UlElement {
+	return UlElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e UlElement) Dir(value string) UlElement { return UlElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e UlElement) Draggable(value string) UlElement {
+	return UlElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e UlElement) Hidden() UlElement { return UlElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e UlElement) Inert() UlElement { return UlElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e UlElement) Lang(value string) UlElement { return UlElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e UlElement) Popover(value string) UlElement {
+	return UlElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e UlElement) Role(value string) UlElement { return UlElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e UlElement) Spellcheck(value string) UlElement {
+	return UlElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e UlElement) TabIndex(value int) UlElement {
+	return UlElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e UlElement) Title(value string) UlElement { return UlElement{e.Interface.Set(`title`, value)} }
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e UlElement) Translate(value string) UlElement {
+	return UlElement{e.Interface.Set(`translate`, value)}
+}
+
+// VarElement is the <var> element, built on tag.Interface.
+type VarElement struct{ tag.Interface }
+
+// Var returns a new <var> element with the provided content.
+func Var(content ...html.Content) VarElement { return VarElement{tag.New(`var`, content...)} }
+
+var _ tag.Interface = VarElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e VarElement) AccessKey(value string) VarElement {
+	return VarElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e VarElement) AutoFocus() VarElement { return VarElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e VarElement) ContentEditable(value string) VarElement {
+	return VarElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e VarElement) Dir(value string) VarElement { return VarElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e VarElement) Draggable(value string) VarElement {
+	return VarElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e VarElement) Hidden() VarElement { return VarElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e VarElement) Inert() VarElement { return VarElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e VarElement) Lang(value string) VarElement { return VarElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e VarElement) Popover(value string) VarElement {
+	return VarElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e VarElement) Role(value string) VarElement { return VarElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e VarElement) Spellcheck(value string) VarElement {
+	return VarElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e VarElement) TabIndex(value int) VarElement {
+	return VarElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e VarElement) Title(value string) VarElement {
+	return VarElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e VarElement) Translate(value string) VarElement {
+	return VarElement{e.Interface.Set(`translate`, value)}
+}
+
+// VideoElement is the <video> element, built on tag.Interface.
+type VideoElement struct{ tag.Interface }
+
+// Video returns a new <video> element with the provided content.
+func Video(content ...html.Content) VideoElement { return VideoElement{tag.New(`video`, content...)} }
+
+var _ tag.Interface = VideoElement{}
+
+// Src returns a copy of the element with the "src" attribute set to a value.
+func (e VideoElement) Src(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`src`, value)}
+}
+
+// CrossOrigin returns a copy of the element with the "crossorigin" attribute set to a value.
+func (e VideoElement) CrossOrigin(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`crossorigin`, value)}
+}
+
+// Poster returns a copy of the element with the "poster" attribute set to a value.
+func (e VideoElement) Poster(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`poster`, value)}
+}
+
+// Preload returns a copy of the element with the "preload" attribute set to a value.
+func (e VideoElement) Preload(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`preload`, value)}
+}
+
+// AutoPlay returns a copy of the element with the boolean "autoplay" attribute.
+func (e VideoElement) AutoPlay() VideoElement { return VideoElement{e.Interface.Set(`autoplay`)} }
+
+// PlaysInline returns a copy of the element with the boolean "playsinline" attribute.
+func (e VideoElement) PlaysInline() VideoElement { return VideoElement{e.Interface.Set(`playsinline`)} }
+
+// Loop returns a copy of the element with the boolean "loop" attribute.
+func (e VideoElement) Loop() VideoElement { return VideoElement{e.Interface.Set(`loop`)} }
+
+// Muted returns a copy of the element with the boolean "muted" attribute.
+func (e VideoElement) Muted() VideoElement { return VideoElement{e.Interface.Set(`muted`)} }
+
+// Controls returns a copy of the element with the boolean "controls" attribute.
+func (e VideoElement) Controls() VideoElement { return VideoElement{e.Interface.Set(`controls`)} }
+
+// Width returns a copy of the element with the "width" attribute set to an integer.
+func (e VideoElement) Width(value int) VideoElement {
+	return VideoElement{e.Interface.Set(`width`, value)}
+}
+
+// Height returns a copy of the element with the "height" attribute set to an integer.
+func (e VideoElement) Height(value int) VideoElement {
+	return VideoElement{e.Interface.Set(`height`, value)}
+}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e VideoElement) AccessKey(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e VideoElement) AutoFocus() VideoElement { return VideoElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e VideoElement) ContentEditable(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e VideoElement) Dir(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`dir`, value)}
+}
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e VideoElement) Draggable(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e VideoElement) Hidden() VideoElement { return VideoElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e VideoElement) Inert() VideoElement { return VideoElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e VideoElement) Lang(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`lang`, value)}
+}
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e VideoElement) Popover(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e VideoElement) Role(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`role`, value)}
+}
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e VideoElement) Spellcheck(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e VideoElement) TabIndex(value int) VideoElement {
+	return VideoElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e VideoElement) Title(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e VideoElement) Translate(value string) VideoElement {
+	return VideoElement{e.Interface.Set(`translate`, value)}
+}
+
+// WbrElement is the <wbr> element, built on tag.Interface.
+type WbrElement struct{ tag.Interface }
+
+// Wbr returns a new <wbr> element, which is a void element that cannot have content.
+func Wbr() WbrElement { return WbrElement{tag.New(`wbr`)} }
+
+var _ tag.Interface = WbrElement{}
+
+// AccessKey returns a copy of the element with the "accesskey" attribute set to a value.
+func (e WbrElement) AccessKey(value string) WbrElement {
+	return WbrElement{e.Interface.Set(`accesskey`, value)}
+}
+
+// AutoFocus returns a copy of the element with the boolean "autofocus" attribute.
+func (e WbrElement) AutoFocus() WbrElement { return WbrElement{e.Interface.Set(`autofocus`)} }
+
+// ContentEditable returns a copy of the element with the "contenteditable" attribute set to a value.
+func (e WbrElement) ContentEditable(value string) WbrElement {
+	return WbrElement{e.Interface.Set(`contenteditable`, value)}
+}
+
+// Dir returns a copy of the element with the "dir" attribute set to a value.
+func (e WbrElement) Dir(value string) WbrElement { return WbrElement{e.Interface.Set(`dir`, value)} }
+
+// Draggable returns a copy of the element with the "draggable" attribute set to a value.
+func (e WbrElement) Draggable(value string) WbrElement {
+	return WbrElement{e.Interface.Set(`draggable`, value)}
+}
+
+// Hidden returns a copy of the element with the boolean "hidden" attribute.
+func (e WbrElement) Hidden() WbrElement { return WbrElement{e.Interface.Set(`hidden`)} }
+
+// Inert returns a copy of the element with the boolean "inert" attribute.
+func (e WbrElement) Inert() WbrElement { return WbrElement{e.Interface.Set(`inert`)} }
+
+// Lang returns a copy of the element with the "lang" attribute set to a value.
+func (e WbrElement) Lang(value string) WbrElement { return WbrElement{e.Interface.Set(`lang`, value)} }
+
+// Popover returns a copy of the element with the "popover" attribute set to a value.
+func (e WbrElement) Popover(value string) WbrElement {
+	return WbrElement{e.Interface.Set(`popover`, value)}
+}
+
+// Role returns a copy of the element with the "role" attribute set to a value.
+func (e WbrElement) Role(value string) WbrElement { return WbrElement{e.Interface.Set(`role`, value)} }
+
+// Spellcheck returns a copy of the element with the "spellcheck" attribute set to a value.
+func (e WbrElement) Spellcheck(value string) WbrElement {
+	return WbrElement{e.Interface.Set(`spellcheck`, value)}
+}
+
+// TabIndex returns a copy of the element with the "tabindex" attribute set to an integer.
+func (e WbrElement) TabIndex(value int) WbrElement {
+	return WbrElement{e.Interface.Set(`tabindex`, value)}
+}
+
+// Title returns a copy of the element with the "title" attribute set to a value.
+func (e WbrElement) Title(value string) WbrElement {
+	return WbrElement{e.Interface.Set(`title`, value)}
+}
+
+// Translate returns a copy of the element with the "translate" attribute set to a value.
+func (e WbrElement) Translate(value string) WbrElement {
+	return WbrElement{e.Interface.Set(`translate`, value)}
+}
@@ -0,0 +1,108 @@
+//go:build ignore
+
+// gen.go generates elements.go from the WHATWG element table in internal/whatwg.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/swdunlop/html-go/internal/whatwg"
+)
+
+func main() {
+	var elements []element
+	for _, elem := range whatwg.Elements() {
+		if elem.Obsolete || elem.Foreign {
+			continue
+		}
+		e := element{Element: elem, Func: goName(elem.Name)}
+		e.Type = e.Func + `Element`
+		seen := make(map[string]bool)
+		for _, attrs := range [][]whatwg.Attribute{elem.Attributes, whatwg.Globals()} {
+			for _, attr := range attrs {
+				if reserved[attr.GoName] || seen[attr.GoName] {
+					fail(`%v has an attribute %q whose method %v is reserved or repeated`, elem.Name, attr.Name, attr.GoName)
+				}
+				seen[attr.GoName] = true
+				e.Attributes = append(e.Attributes, attr)
+			}
+		}
+		elements = append(elements, e)
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, elements)
+	if err != nil {
+		fail(`%v`, err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		fail(`%v`, err)
+	}
+	err = os.WriteFile(`elements.go`, src, 0o644)
+	if err != nil {
+		fail(`%v`, err)
+	}
+}
+
+type element struct {
+	*whatwg.Element
+	Func       string             // the name of the constructor, like "Input"
+	Type       string             // the name of the type, like "InputElement"
+	Attributes []whatwg.Attribute // element specific attributes followed by global attributes
+}
+
+// reserved lists the methods of tag.Interface, which every element implements, so attribute setters must not replace
+// them.
+var reserved = map[string]bool{
+	`AppendHTML`: true, `AppendHTMLContext`: true, `StreamHTML`: true, `TagName`: true, `Children`: true,
+	`WithChildren`: true, `ID`: true, `Class`: true, `Set`: true, `Attr`: true, `Attributes`: true, `Unset`: true,
+	`RemoveClass`: true, `SetIf`: true, `Toggle`: true, `Data`: true, `Aria`: true, `Style`: true, `Add`: true,
+	`Text`: true, `HTML`: true, `Defer`: true, `DeferContext`: true, `Interface`: true,
+}
+
+func goName(name string) string { return strings.ToUpper(name[:1]) + name[1:] }
+
+func fail(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "!! "+format+"\n", args...)
+	os.Exit(1)
+}
+
+var tmpl = template.Must(template.New(`elements`).Parse(`// Code generated by gen.go from internal/whatwg/elements.txt; DO NOT EDIT.
+
+package el
+
+import (
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+)
+{{range .}}{{$type := .Type}}
+// {{.Type}} is the <{{.Name}}> element, built on tag.Interface.
+type {{.Type}} struct{ tag.Interface }
+{{if .Void}}
+// {{.Func}} returns a new <{{.Name}}> element, which is a void element that cannot have content.
+func {{.Func}}() {{.Type}} { return {{.Type}}{tag.New(` + "`{{.Name}}`" + `)} }
+{{else}}
+// {{.Func}} returns a new <{{.Name}}> element with the provided content.
+func {{.Func}}(content ...html.Content) {{.Type}} { return {{.Type}}{tag.New(` + "`{{.Name}}`" + `, content...)} }
+{{end}}
+var _ tag.Interface = {{.Type}}{}
+{{range .Attributes}}
+{{- if eq .Type 1}}
+// {{.GoName}} returns a copy of the element with the boolean "{{.Name}}" attribute.
+func (e {{$type}}) {{.GoName}}() {{$type}} { return {{$type}}{e.Interface.Set(` + "`{{.Name}}`" + `)} }
+{{else if eq .Type 2}}
+// {{.GoName}} returns a copy of the element with the "{{.Name}}" attribute set to an integer.
+func (e {{$type}}) {{.GoName}}(value int) {{$type}} { return {{$type}}{e.Interface.Set(` + "`{{.Name}}`" + `, value)} }
+{{else if eq .Type 3}}
+// {{.GoName}} returns a copy of the element with the "{{.Name}}" attribute set to a number.
+func (e {{$type}}) {{.GoName}}(value float64) {{$type}} { return {{$type}}{e.Interface.Set(` + "`{{.Name}}`" + `, value)} }
+{{else}}
+// {{.GoName}} returns a copy of the element with the "{{.Name}}" attribute set to a value.
+func (e {{$type}}) {{.GoName}}(value string) {{$type}} { return {{$type}}{e.Interface.Set(` + "`{{.Name}}`" + `, value)} }
+{{end}}{{end}}{{end}}`))
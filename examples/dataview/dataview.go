@@ -7,6 +7,7 @@ import (
 
 	"github.com/swdunlop/html-go"
 	"github.com/swdunlop/html-go/dataview"
+	"github.com/swdunlop/html-go/page"
 	"github.com/tidwall/gjson"
 )
 
@@ -20,17 +21,14 @@ func main() {
 	}
 	data := gjson.ParseBytes(js)
 
-	doc := html.Append(make([]byte, 0, 1024*1024), html.Group{
-		html.HTML(`<!DOCTYPE html><html><head><meta charset="utf-8">`),
-		html.HTML(`<style>`),
-		html.HTML(css),
-		html.HTML(`</style></head><body>`),
-		dataview.FromGJSON(data, dataview.Hook(rxOData, func(path string, data gjson.Result) html.Content {
+	doc := html.Append(make([]byte, 0, 1024*1024), page.New(``).Head(
+		html.InlineStyle(css),
+	).Body(
+		dataview.FromGJSON(data, dataview.WithStylesheet(), dataview.Hook(rxOData, func(path string, data gjson.Result) html.Content {
 			// We ellide @odata fields as uninteresting to the user.
 			return ellide
 		})),
-		html.HTML(`</body></html>`),
-	})
+	))
 
 	_, err = os.Stdout.Write(doc)
 	if err != nil {
@@ -41,8 +39,8 @@ func main() {
 var rxOData = regexp.MustCompile(`(?:^|\.)@odata[\.|$]`)
 var ellide = html.HTML(`<div class="ellide">…</div>`)
 
-// css extends the structural CSS from the dataview with colors, fonts and spacing.
-var css = dataview.Stylesheet() + `
+// css extends the structural CSS from the dataview, which it adds to the head, with colors, fonts and spacing.
+var css = `
 body{ background-color: #111; color: #eee; font-family: sans-serif; }
 .object, .array, .table { border-top: 2px solid #888; }
 .label { font-weight: bold; background-color: #333; }
@@ -3,7 +3,6 @@ package main
 import (
 	"net/http"
 	"os"
-	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -12,6 +11,8 @@ import (
 	"github.com/swdunlop/html-go"
 	"github.com/swdunlop/html-go/deadmanswitch"
 	"github.com/swdunlop/html-go/hog"
+	"github.com/swdunlop/html-go/page"
+	"github.com/swdunlop/html-go/tag"
 )
 
 func main() {
@@ -23,26 +24,13 @@ func main() {
 	dms := deadmanswitch.New(deadmanswitch.ReloadOnReconnect())
 	r.Method(`GET`, dms.Path(), dms)
 	r.Get(`/`, func(w http.ResponseWriter, r *http.Request) {
-		render(w, r, 200,
-			html.HTML(`<html><body>`),
-			html.HTML(`<h1>Server Started: `),
-			html.Text(start),
-			html.HTML(`</h1>`),
+		err := html.Render(w, r, 200, page.New(`Dead Man's Switch`).Body(
+			tag.New(`h1`).Text(`Server Started: `+start),
 			dms,
-			html.HTML(`</body></html>`),
-		)
+		))
+		if err != nil {
+			hog.For(r).Warn().Err(err).Msg(``)
+		}
 	})
 	http.ListenAndServe("localhost:8181", r)
 }
-
-func render(w http.ResponseWriter, r *http.Request, status int, content ...html.Content) {
-	p := html.Append(make([]byte, 0, 16384), content...)
-	h := w.Header()
-	h.Set(`Content-Type`, `text/html; encoding=utf-8`)
-	h.Set(`Content-Length`, strconv.Itoa(len(p)))
-	w.WriteHeader(status)
-	_, err := w.Write(p)
-	if err != nil {
-		hog.For(r).Warn().Err(err).Msg(``)
-	}
-}
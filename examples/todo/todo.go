@@ -1,16 +1,12 @@
 package main
 
 import (
-	"bytes"
-	"compress/gzip"
 	"errors"
 	"net/http"
 	"sort"
-	"strconv"
-	"strings"
-	"sync"
 
 	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/page"
 	"github.com/swdunlop/html-go/tag"
 )
 
@@ -83,48 +79,19 @@ type Item struct {
 
 // provideContent checks to see if the request is a HX-Requst -- if so, it provides just the content and a title.
 func provideContent(w http.ResponseWriter, r *http.Request, status int, titleText string, content ...html.Content) {
-	buf := make([]byte, 0, 65536)
-	h := r.Header
-	if h.Get(`HX-Request`) == `true` {
-		buf = html.Append(buf, title.Text(titleText), html.Group(content))
-	} else {
-		buf = html.Append(buf, beforeTitle, title.Text(titleText), beforeBody, html.Group(content), afterBody)
+	var doc html.Content = page.New(titleText).Body(content...)
+	if r.Header.Get(`HX-Request`) == `true` {
+		doc = html.Group{title.Text(titleText), html.Group(content)}
 	}
-	if strings.Contains(h.Get(`Accept-Encoding`), `gzip`) {
-		w.Header().Set(`Content-Encoding`, `gzip`)
-		buf = compress(buf)
-	}
-	h = w.Header()
-	h.Set(`Content-Type`, `text/html; charset=utf-8`)
+	h := w.Header()
 	h.Set(`Cache-Control`, `no-cache, no-store, must-revalidate`)
 	h.Set(`Pragma`, `no-cache`)
 	h.Set(`Expires`, `0`)
-	h.Set(`Content-Length`, strconv.Itoa(len(buf)))
-	w.WriteHeader(status)
-	_, _ = w.Write(buf)
-}
-
-func compress(buf []byte) []byte {
-	var tmp bytes.Buffer
-	tmp.Grow(len(buf) + 16)
-	w := gzipPool.Get().(*gzip.Writer)
-	defer gzipPool.Put(w)
-	w.Reset(&tmp)
-	w.Write(buf)
-	w.Close()
-	return tmp.Bytes()
+	h.Add(`Vary`, `HX-Request`)
+	_ = html.Render(w, r, status, doc)
 }
 
-var gzipPool = sync.Pool{
-	New: func() interface{} { w, _ := gzip.NewWriterLevel(nil, gzip.BestSpeed); return w },
-}
-
-var (
-	title       = tag.New(`title`)
-	beforeTitle = html.HTML(`<!DOCTYPE html><html><head>`)
-	beforeBody  = html.HTML(`</head><body>`)
-	afterBody   = html.HTML(`</body></html>`)
-)
+var title = tag.New(`title`)
 
 // type List struct {
 // 	Items   []Item `json:"items"`
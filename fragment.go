@@ -0,0 +1,70 @@
+package html
+
+import (
+	"context"
+	"slices"
+)
+
+// Fragment returns content that renders only the elements of content with one of the IDs, and their content, in the
+// order they appear, which lets a handler answer a request for part of a page, like an htmx request, by rendering the
+// whole page.  Elements are found by the tag package, and by any other ContextContent that uses SearchingFragment and
+// MatchFragment; other content outside of the elements, like Text and HTML, is skipped, so IDs inside HTML or Static
+// content are not found.  If no element has one of the IDs, nothing is rendered.
+//
+// Content that is skipped is still generated, so a Func or ContextFunc outside of the elements is still called;
+// content that is expensive to generate should be added to the page inside the element that needs it.
+func Fragment(content Content, ids ...string) Content {
+	return fragment{content: content, ids: ids}
+}
+
+type fragment struct {
+	content Content
+	ids     []string
+}
+
+// AppendHTML implements Content by rendering the fragment with context.Background().
+func (f fragment) AppendHTML(buf []byte) []byte {
+	return f.AppendHTMLContext(context.Background(), buf)
+}
+
+// AppendHTMLContext implements ContextContent by searching the content for the elements with the IDs.
+func (f fragment) AppendHTMLContext(ctx context.Context, buf []byte) []byte {
+	if len(f.ids) == 0 {
+		return buf
+	}
+	search := &fragmentSearch{ids: slices.Clone(f.ids)}
+	return AppendContext(context.WithValue(ctx, fragmentKey{}, search), buf, f.content)
+}
+
+// SearchingFragment returns true if content rendered with ctx is being searched for a fragment, which means the content
+// should only render elements whose IDs are matched by MatchFragment, searching its own content otherwise.
+func SearchingFragment(ctx context.Context) bool { return searchOf(ctx) != nil }
+
+// MatchFragment returns true if an element with the ID is part of the fragment being searched for in ctx, and
+// returns a context for rendering the element and its content as usual.  Each ID only matches once.
+func MatchFragment(ctx context.Context, id string) (context.Context, bool) {
+	search := searchOf(ctx)
+	if search == nil || id == `` {
+		return ctx, false
+	}
+	i := slices.Index(search.ids, id)
+	if i < 0 {
+		return ctx, false
+	}
+	search.ids = slices.Delete(search.ids, i, i+1)
+	return context.WithValue(ctx, fragmentKey{}, (*fragmentSearch)(nil)), true
+}
+
+// fragmentKey marks a context used to search for a fragment with a *fragmentSearch, which is nil inside the elements
+// that were found.
+type fragmentKey struct{}
+
+// fragmentSearch tracks the IDs that have not been found yet, which lets rendering stop once all of them are found.
+type fragmentSearch struct {
+	ids []string
+}
+
+func searchOf(ctx context.Context) *fragmentSearch {
+	search, _ := ctx.Value(fragmentKey{}).(*fragmentSearch)
+	return search
+}
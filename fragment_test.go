@@ -0,0 +1,51 @@
+package html_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/el"
+	"github.com/swdunlop/html-go/tag"
+)
+
+func TestFragment(t *testing.T) {
+	calls := 0
+	page := tag.New(`html`).Add(
+		tag.New(`body`).Text(`Skipped text`).Add(
+			tag.New(`h1#title`).Text(`Title`),
+			html.HTML(`<p id="opaque">Skipped HTML</p>`),
+			html.ContextFunc(func(ctx context.Context) html.Content {
+				calls++
+				return tag.New(`ul#list`).Add(tag.New(`li`).Text(`One`), tag.New(`li#two`).Text(`Two`))
+			}),
+			el.Section(tag.New(`p`).Text(`Inner`)).Set(`id`, `section`),
+			tag.New(`script`).Text(`skipped()`),
+		),
+	)
+	test := func(expect string, ids ...string) {
+		t.Helper()
+		result := string(html.Append(nil, html.Fragment(page, ids...)))
+		if result != expect {
+			t.Errorf("%q: expected %q, got %q", ids, expect, result)
+		}
+	}
+	test(`<h1 id='title'>Title</h1>`, `title`)
+	test(`<ul id='list'><li>One</li><li id='two'>Two</li></ul>`, `list`)
+	test(`<li id='two'>Two</li>`, `two`)
+	test(`<section id='section'><p>Inner</p></section>`, `section`)
+	test(`<h1 id='title'>Title</h1><li id='two'>Two</li>`, `two`, `title`)
+	test(``, `opaque`)
+	test(``)
+
+	calls = 0
+	test(`<h1 id='title'>Title</h1>`, `title`)
+	if calls != 0 {
+		t.Errorf("expected rendering to stop once the fragment was found, but the ContextFunc was called")
+	}
+
+	minified := string(html.AppendContext(html.Minify(context.Background()), nil, html.Fragment(page, `list`)))
+	if expect := `<ul id=list><li>One<li id=two>Two</ul>`; minified != expect {
+		t.Errorf("expected %q when minified, got %q", expect, minified)
+	}
+}
@@ -23,7 +23,7 @@ func (group Group) AppendHTML(buf []byte) []byte {
 
 // Static merges the provided HTML content into static content, speeding up subsequent addition as HTML.
 func Static(elements ...Content) Content {
-	return HTML(Append(make([]byte, 0, 1024), elements...))
+	return HTML(Append(nil, elements...))
 }
 
 // HTML is static HTML content.
@@ -0,0 +1,176 @@
+// Package htmltest helps test content built with this module without comparing exact strings, which break whenever
+// attributes are reordered or whitespace changes.  AssertEqualHTML compares normalized HTML, Snapshot compares content
+// with a file in testdata that can be regenerated with "go test -update", and Find returns the elements that match a
+// selector, so a test can check one part of a page.
+package htmltest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/internal/whatwg"
+	"github.com/swdunlop/html-go/pretty"
+	"github.com/swdunlop/html-go/query"
+	"github.com/swdunlop/html-go/tag"
+)
+
+var update = flag.Bool(`update`, false, `update the snapshot files used by htmltest.Snapshot`)
+
+// Updating returns true if tests were run with the -update flag, which this package defines, so tests that keep other
+// golden files can update them at the same time.
+func Updating() bool { return *update }
+
+// AssertEqualHTML reports an error with a line by line difference if the content does not render the same HTML as
+// want, after both are normalized by Normalize.
+func AssertEqualHTML(t testing.TB, got html.Content, want string) {
+	t.Helper()
+	result, expect := Normalize(got), normalize([]byte(want))
+	if result != expect {
+		t.Errorf("HTML does not match, - want + got:\n%v", diff(expect, result))
+	}
+}
+
+// Snapshot compares the content with the file "testdata/<name>.html", reporting an error with a line by line
+// difference if they do not match after both are normalized.  If tests are run with -update, the file is written
+// instead, using the normalized content, which keeps snapshots readable in code review.  If the name is empty, the
+// name of the test is used.
+func Snapshot(t testing.TB, name string, got html.Content) {
+	t.Helper()
+	if name == `` {
+		name = strings.NewReplacer(`/`, `_`, ` `, `_`).Replace(t.Name())
+	}
+	path := filepath.Join(`testdata`, name+`.html`)
+	result := Normalize(got)
+	if *update {
+		err := os.MkdirAll(`testdata`, 0o755)
+		if err == nil {
+			err = os.WriteFile(path, []byte(result), 0o644)
+		}
+		if err != nil {
+			t.Fatalf("cannot update snapshot: %v", err)
+		}
+		return
+	}
+	src, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		t.Fatalf("snapshot %v does not exist, run the test with -update to create it", path)
+	case err != nil:
+		t.Fatalf("cannot read snapshot: %v", err)
+	}
+	if expect := normalize(src); result != expect {
+		t.Errorf("snapshot %v does not match, - want + got:\n%v", path, diff(expect, result))
+	}
+}
+
+// Normalize renders the content and returns it in a form that can be compared with other normalized HTML.  Elements
+// are parsed like tag.Parse, so end tags that can be implied do not matter; attributes are sorted by name, classes are
+// sorted, values are quoted the same way, and whitespace is changed like pretty.Format, so insignificant whitespace
+// does not matter either.
+func Normalize(content html.Content) string {
+	return normalize(html.AppendContext(context.Background(), nil, content))
+}
+
+func normalize(src []byte) string {
+	group, _ := tag.Parse(bytes.NewReader(src)) // reading from memory cannot fail.
+	return string(pretty.Format(nil, appendNormal(nil, group, false)))
+}
+
+func appendNormal(buf []byte, content []html.Content, raw bool) []byte {
+	for _, item := range content {
+		t, ok := item.(tag.Interface)
+		if !ok {
+			if text, ok := item.(html.Text); ok && raw {
+				buf = append(buf, text...)
+			} else {
+				buf = item.AppendHTML(buf)
+			}
+			continue
+		}
+		buf = append(buf, '<')
+		buf = append(buf, t.TagName()...)
+		attrs := make([][2]string, 0, 8)
+		for name, value := range t.Attributes() {
+			if name == `class` {
+				classes := strings.Fields(value)
+				slices.Sort(classes)
+				value = strings.Join(classes, ` `)
+			}
+			attrs = append(attrs, [2]string{name, value})
+		}
+		slices.SortFunc(attrs, func(a, b [2]string) int { return strings.Compare(a[0], b[0]) })
+		for _, attr := range attrs {
+			buf = append(buf, ' ')
+			buf = append(buf, attr[0]...)
+			if attr[1] != `` {
+				buf = append(buf, `='`...)
+				buf = html.AppendAttrText(buf, attr[1])
+				buf = append(buf, '\'')
+			}
+		}
+		buf = append(buf, '>')
+		elem, known := whatwg.Lookup(t.TagName())
+		if known && elem.Void {
+			continue
+		}
+		buf = appendNormal(buf, t.Children(), known && elem.RawText)
+		buf = append(buf, `</`...)
+		buf = append(buf, t.TagName()...)
+		buf = append(buf, '>')
+	}
+	return buf
+}
+
+// diff returns a line by line difference between want and got, prefixing lines only in want with "-", lines only in
+// got with "+" and other lines with a space.
+func diff(want, got string) string {
+	a, b := strings.Split(want, "\n"), strings.Split(got, "\n")
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var buf strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			buf.WriteString(`  ` + a[i] + "\n")
+			i, j = i+1, j+1
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			buf.WriteString(`+ ` + b[j] + "\n")
+			j++
+		default:
+			buf.WriteString(`- ` + a[i] + "\n")
+			i++
+		}
+	}
+	return buf.String()
+}
+
+// Find renders the content and returns the elements that match the selector, in document order, parsed like
+// tag.Parse.  The selector can use anything supported by the query package, like `form input[name=csrf]` or
+// `ul > li:first-child`.  Find panics if the selector is not valid, like query.MustCompile.
+func Find(content html.Content, selector string) []tag.Interface {
+	sel := query.MustCompile(selector)
+	group, _ := tag.Parse(bytes.NewReader(html.AppendContext(context.Background(), nil, content)))
+	return sel.All(group)
+}
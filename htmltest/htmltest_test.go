@@ -0,0 +1,105 @@
+package htmltest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/htmltest"
+	"github.com/swdunlop/html-go/tag"
+)
+
+// recorder records errors instead of failing the test, so failures can be tested.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) { r.Errorf(format, args...) }
+
+func TestAssertEqualHTML(t *testing.T) {
+	form := tag.New(`form.login.wide[method=post]`).Add(
+		tag.New(`input[name=user][required]`),
+		tag.New(`ul`).Add(tag.New(`li`).Text(`One`), tag.New(`li`).Text(`Two`)),
+	)
+	htmltest.AssertEqualHTML(t, form, `
+		<form method="post" class="wide login">
+			<input required="" name=user>
+			<ul>
+				<li>One
+				<li>Two
+			</ul>
+		</form>`)
+
+	r := &recorder{TB: t}
+	htmltest.AssertEqualHTML(r, form, `<form class="login wide" method="get"><input name="user" required></form>`)
+	if len(r.errors) != 1 {
+		t.Fatalf("expected one error, got %v", r.errors)
+	}
+	for _, line := range []string{
+		`- <form class='login wide' method='get'><input name='user' required></form>`,
+		`+ <form class='login wide' method='post'>`,
+		`+   <input name='user' required>`,
+		`+   <ul>`,
+	} {
+		if !strings.Contains(r.errors[0], "\n"+line+"\n") {
+			t.Errorf("expected %q in the difference, got:\n%v", line, r.errors[0])
+		}
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	htmltest.Snapshot(t, ``, tag.New(`main`).Add(
+		tag.New(`h1`).Text(`Hello`),
+		tag.New(`p.lead`).Text(`Hello, `).Add(tag.New(`b`).Text(`World`)),
+	))
+
+	if htmltest.Updating() {
+		return
+	}
+	r := &recorder{TB: t}
+	htmltest.Snapshot(r, `missing`, html.Text(`Hello`))
+	if len(r.errors) == 0 || !strings.Contains(r.errors[0], `run the test with -update`) {
+		t.Errorf("expected an error about a missing snapshot, got %v", r.errors)
+	}
+}
+
+func TestFind(t *testing.T) {
+	page := tag.New(`main`).Add(
+		tag.New(`form#search`).Add(tag.New(`input[name=q]`)),
+		tag.New(`form.login`).Add(
+			tag.New(`div`).Add(tag.New(`input[type=hidden][name=csrf][value=abc]`)),
+			tag.New(`input[name=user]`),
+		),
+	)
+	test := func(sel string, expect ...string) {
+		t.Helper()
+		var result []string
+		for _, found := range htmltest.Find(page, sel) {
+			result = append(result, string(html.Append(nil, found)))
+		}
+		if strings.Join(result, "\n") != strings.Join(expect, "\n") {
+			t.Errorf("%v: expected %q, got %q", sel, expect, result)
+		}
+	}
+	test(`form input[name=csrf]`, `<input type='hidden' name='csrf' value='abc'>`)
+	test(`main form.login input`, `<input type='hidden' name='csrf' value='abc'>`, `<input name='user'>`)
+	test(`#search input`, `<input name='q'>`)
+	test(`form div[name]`)
+	test(`form > input`, `<input name='q'>`, `<input name='user'>`)
+	test(`p`)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected Find to panic for an invalid selector")
+		}
+	}()
+	htmltest.Find(page, `form >`)
+}
@@ -22,6 +22,16 @@ func RenderPage(r *http.Request, page func(PartMap) html.Content, parts ...Part)
 	return page(table)
 }
 
+// RenderFragment returns the page if the HX-Target header is not present, otherwise, it uses html.Fragment to render
+// only the targeted element of the page, so a page does not need to be split into parts to answer htmx requests.
+func RenderFragment(r *http.Request, page html.Content) html.Content {
+	target := r.Header.Get(`HX-Target`)
+	if target == `` {
+		return page
+	}
+	return html.Fragment(page, target)
+}
+
 // Render parses the HX-Target header and returns the part that matches.  This will return an empty html.Group if no
 // parts match.
 //
@@ -0,0 +1,148 @@
+// Package aria lists the states and properties defined by WAI-ARIA 1.2, with the values they accept, for use by
+// packages that generate or check aria-* attributes.
+package aria
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// A Type describes the kind of value accepted by an ARIA attribute.
+type Type int
+
+const (
+	String  Type = iota // any string
+	Boolean             // "true" or "false"
+	Token               // one of the listed tokens
+	Tokens              // a space separated list of the listed tokens
+	IDRef               // the ID of another element
+	IDRefs              // a space separated list of IDs
+	Integer             // an integer
+	Number              // a number
+)
+
+// An Attribute describes an ARIA state or property.
+type Attribute struct {
+	Type       Type
+	Tokens     []string // the tokens accepted by Token and Tokens attributes
+	Deprecated bool     // deprecated attributes are still valid, but should not be used in new content
+}
+
+// Lookup returns the ARIA attribute with the provided name, which must include the "aria-" prefix.
+func Lookup(name string) (Attribute, bool) {
+	attr, ok := attributes[name]
+	return attr, ok
+}
+
+// Valid returns true if the value is acceptable for the attribute, ignoring ASCII case for tokens.
+func (attr Attribute) Valid(value string) bool {
+	switch attr.Type {
+	case Boolean:
+		return value == `true` || value == `false`
+	case Token:
+		return attr.hasToken(value)
+	case Tokens:
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			return false
+		}
+		for _, field := range fields {
+			if !attr.hasToken(field) {
+				return false
+			}
+		}
+		return true
+	case IDRef:
+		return value != `` && !strings.ContainsAny(value, " \t\r\n\f")
+	case IDRefs:
+		return strings.TrimSpace(value) != ``
+	case Integer:
+		_, err := strconv.Atoi(value)
+		return err == nil
+	case Number:
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	}
+	return true
+}
+
+func (attr Attribute) hasToken(value string) bool {
+	return slices.ContainsFunc(attr.Tokens, func(token string) bool { return strings.EqualFold(token, value) })
+}
+
+var (
+	boolean = Attribute{Type: Boolean}
+	str     = Attribute{Type: String}
+	idref   = Attribute{Type: IDRef}
+	idrefs  = Attribute{Type: IDRefs}
+	integer = Attribute{Type: Integer}
+	number  = Attribute{Type: Number}
+)
+
+// undefinable returns a Token attribute that also accepts "undefined", the default for many ARIA states.
+func undefinable(tokens ...string) Attribute {
+	return Attribute{Type: Token, Tokens: append(tokens, `undefined`)}
+}
+
+var attributes = map[string]Attribute{
+	`aria-activedescendant`:       idref,
+	`aria-atomic`:                 boolean,
+	`aria-autocomplete`:           {Type: Token, Tokens: []string{`inline`, `list`, `both`, `none`}},
+	`aria-braillelabel`:           str,
+	`aria-brailleroledescription`: str,
+	`aria-busy`:                   boolean,
+	`aria-checked`:                undefinable(`true`, `false`, `mixed`),
+	`aria-colcount`:               integer,
+	`aria-colindex`:               integer,
+	`aria-colindextext`:           str,
+	`aria-colspan`:                integer,
+	`aria-controls`:               idrefs,
+	`aria-current`: {
+		Type: Token, Tokens: []string{`page`, `step`, `location`, `date`, `time`, `true`, `false`},
+	},
+	`aria-describedby`: idrefs,
+	`aria-description`: str,
+	`aria-details`:     idref,
+	`aria-disabled`:    boolean,
+	`aria-dropeffect`: {
+		Type: Tokens, Tokens: []string{`copy`, `execute`, `link`, `move`, `none`, `popup`}, Deprecated: true,
+	},
+	`aria-errormessage`: idref,
+	`aria-expanded`:     undefinable(`true`, `false`),
+	`aria-flowto`:       idrefs,
+	`aria-grabbed`:      {Type: Token, Tokens: []string{`true`, `false`, `undefined`}, Deprecated: true},
+	`aria-haspopup`: {
+		Type: Token, Tokens: []string{`false`, `true`, `menu`, `listbox`, `tree`, `grid`, `dialog`},
+	},
+	`aria-hidden`:          undefinable(`true`, `false`),
+	`aria-invalid`:         {Type: Token, Tokens: []string{`grammar`, `false`, `spelling`, `true`}},
+	`aria-keyshortcuts`:    str,
+	`aria-label`:           str,
+	`aria-labelledby`:      idrefs,
+	`aria-level`:           integer,
+	`aria-live`:            {Type: Token, Tokens: []string{`assertive`, `off`, `polite`}},
+	`aria-modal`:           boolean,
+	`aria-multiline`:       boolean,
+	`aria-multiselectable`: boolean,
+	`aria-orientation`:     undefinable(`horizontal`, `vertical`),
+	`aria-owns`:            idrefs,
+	`aria-placeholder`:     str,
+	`aria-posinset`:        integer,
+	`aria-pressed`:         undefinable(`true`, `false`, `mixed`),
+	`aria-readonly`:        boolean,
+	`aria-relevant`:        {Type: Tokens, Tokens: []string{`additions`, `all`, `removals`, `text`}},
+	`aria-required`:        boolean,
+	`aria-roledescription`: str,
+	`aria-rowcount`:        integer,
+	`aria-rowindex`:        integer,
+	`aria-rowindextext`:    str,
+	`aria-rowspan`:         integer,
+	`aria-selected`:        undefinable(`true`, `false`),
+	`aria-setsize`:         integer,
+	`aria-sort`:            {Type: Token, Tokens: []string{`ascending`, `descending`, `none`, `other`}},
+	`aria-valuemax`:        number,
+	`aria-valuemin`:        number,
+	`aria-valuenow`:        number,
+	`aria-valuetext`:       str,
+}
@@ -0,0 +1,73 @@
+// Package capture buffers HTTP responses so middleware can inspect or rewrite HTML before it is sent to the client.
+package capture
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// A Response is a http.ResponseWriter that buffers the status, header and body of a response.
+type Response struct {
+	Status int          // Status is the status code of the response, or zero if nothing has been written yet.
+	Body   bytes.Buffer // Body holds everything written to the response.
+	header http.Header
+}
+
+// New returns a response that starts with a copy of the header of w, since handlers may have been given headers by
+// earlier middleware.
+func New(w http.ResponseWriter) *Response {
+	return &Response{header: w.Header().Clone()}
+}
+
+// Header implements http.ResponseWriter.
+func (rsp *Response) Header() http.Header { return rsp.header }
+
+// WriteHeader implements http.ResponseWriter by recording the first status code written.
+func (rsp *Response) WriteHeader(status int) {
+	if rsp.Status == 0 {
+		rsp.Status = status
+	}
+}
+
+// Write implements http.ResponseWriter by appending p to the body.
+func (rsp *Response) Write(p []byte) (int, error) {
+	rsp.WriteHeader(http.StatusOK)
+	return rsp.Body.Write(p)
+}
+
+// Flush implements http.Flusher, doing nothing, since the response is only sent when the handler is done.
+func (rsp *Response) Flush() {}
+
+// HTML returns true if the response is uncompressed HTML, detecting the content type if the handler did not set it.
+func (rsp *Response) HTML() bool {
+	if rsp.header.Get(`Content-Encoding`) != `` {
+		return false
+	}
+	contentType := rsp.header.Get(`Content-Type`)
+	if contentType == `` {
+		contentType = http.DetectContentType(rsp.Body.Bytes())
+	}
+	return strings.HasPrefix(contentType, `text/html`)
+}
+
+// Send writes the captured status and header to w, followed by the body, which may have been rewritten.
+func (rsp *Response) Send(w http.ResponseWriter, body []byte) error {
+	header := w.Header()
+	for key := range header {
+		delete(header, key)
+	}
+	for key, values := range rsp.header {
+		header[key] = values
+	}
+	if header.Get(`Content-Length`) != `` {
+		header.Set(`Content-Length`, strconv.Itoa(len(body)))
+	}
+	if rsp.Status == 0 {
+		rsp.Status = http.StatusOK
+	}
+	w.WriteHeader(rsp.Status)
+	_, err := w.Write(body)
+	return err
+}
@@ -0,0 +1,45 @@
+// Package rawtext escapes the text of "script" and "style" tags, which HTML5 does not decode, so it is shared by the
+// tag package and the inline assets in the html package.
+package rawtext
+
+import "strings"
+
+// Append appends the text of a script or style tag named name to buf.  The text is appended verbatim, except that
+// "<!--", "<script" and "</script" (or "</style") are escaped with a backslash, which keeps their meaning in
+// JavaScript strings and CSS while preventing them from ending the tag.
+func Append(buf []byte, name string, text string) []byte {
+	for {
+		ix := indexUnsafe(text, name)
+		if ix < 0 {
+			return append(buf, text...)
+		}
+		buf = append(buf, text[:ix+1]...) // include the "<"
+		buf = append(buf, '\\')
+		text = text[ix+1:]
+	}
+}
+
+// indexUnsafe returns the index of the first "<" that starts "<!--", "<script" or "</" followed by the name of the
+// tag, ignoring case.
+func indexUnsafe(text string, name string) int {
+	offset := 0
+	for {
+		ix := strings.IndexByte(text[offset:], '<')
+		if ix < 0 {
+			return -1
+		}
+		ix += offset
+		rest := text[ix+1:]
+		switch {
+		case len(rest) >= 3 && rest[:3] == `!--`,
+			hasPrefixFold(rest, `script`),
+			len(rest) > 0 && rest[0] == '/' && hasPrefixFold(rest[1:], name):
+			return ix
+		}
+		offset = ix + 1
+	}
+}
+
+func hasPrefixFold(text, prefix string) bool {
+	return len(text) >= len(prefix) && strings.EqualFold(text[:len(prefix)], prefix)
+}
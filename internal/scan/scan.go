@@ -0,0 +1,283 @@
+// Package scan splits HTML into tokens, following the tokenization rules of the WHATWG HTML Living Standard closely
+// enough for markup written by people and produced by this module.  It does not build a tree; see tag.Parse.
+package scan
+
+import (
+	stdhtml "html"
+	"strings"
+
+	"github.com/swdunlop/html-go/internal/whatwg"
+)
+
+// A Kind identifies the kind of a Token.
+type Kind int
+
+const (
+	Text     Kind = iota // text, including the content of raw text elements
+	StartTag             // a start tag, like "<p class=note>"
+	EndTag               // an end tag, like "</p>"
+	Comment              // a comment, like "<!-- note -->", or a bogus comment, like "<?xml ...?>"
+	Doctype              // a doctype, like "<!DOCTYPE html>"
+)
+
+// A Token is a piece of HTML.
+type Token struct {
+	Kind        Kind
+	Name        string      // Name is the name of a start or end tag, in the case it was written.
+	Attributes  []Attribute // Attributes lists the attributes of a start tag in the order they were written.
+	SelfClosing bool        // SelfClosing is true if a start tag ended with "/>".
+	Data        string      // Data is the decoded text of a Text token, or the content of a comment or doctype.
+	Raw         string      // Raw is the source of the token.
+}
+
+// An Attribute is an attribute of a start tag.
+type Attribute struct {
+	Name     string // Name is the attribute name, in the case it was written.
+	Value    string // Value is the decoded value of the attribute.
+	HasValue bool   // HasValue is false for attributes written without "=", like "required".
+}
+
+// Attr returns the value of the named attribute, ignoring ASCII case, and true if it is present.
+func (tok *Token) Attr(name string) (string, bool) {
+	for _, attr := range tok.Attributes {
+		if strings.EqualFold(attr.Name, name) {
+			return attr.Value, true
+		}
+	}
+	return ``, false
+}
+
+// A Scanner produces tokens from HTML source.
+type Scanner struct {
+	src string
+	pos int
+
+	// end is the name of the raw text or RCDATA element whose content is being scanned, or empty.
+	end    string
+	decode bool // decode is true if entities in raw text should be decoded, as in RCDATA elements.
+}
+
+// New returns a scanner for the HTML source.
+func New(src string) *Scanner { return &Scanner{src: src} }
+
+// Offset returns the offset of the next token in the source.
+func (s *Scanner) Offset() int { return s.pos }
+
+// Next returns the next token, or false at the end of the source.
+func (s *Scanner) Next() (Token, bool) {
+	if s.pos >= len(s.src) {
+		return Token{}, false
+	}
+	if s.end != `` {
+		return s.rawText(), true
+	}
+	if s.src[s.pos] == '<' && s.pos+1 < len(s.src) {
+		ch := s.src[s.pos+1]
+		switch {
+		case isLetter(ch):
+			return s.startTag(), true
+		case ch == '/':
+			if s.pos+2 < len(s.src) && isLetter(s.src[s.pos+2]) {
+				return s.endTag(), true
+			}
+			if strings.HasPrefix(s.src[s.pos:], `</>`) {
+				s.pos += 3 // the spec ignores "</>" entirely.
+				return s.Next()
+			}
+			return s.comment(2, `>`), true
+		case ch == '!':
+			switch {
+			case strings.HasPrefix(s.src[s.pos:], `<!--`):
+				return s.comment(4, `-->`), true
+			case len(s.src)-s.pos >= 9 && strings.EqualFold(s.src[s.pos+2:s.pos+9], `doctype`):
+				tok := s.comment(2, `>`)
+				tok.Kind, tok.Data = Doctype, strings.TrimSpace(tok.Data[7:]) // skip "doctype"
+				return tok, true
+			}
+			return s.comment(2, `>`), true
+		case ch == '?':
+			return s.comment(1, `>`), true
+		}
+	}
+	return s.text(), true
+}
+
+// text scans text until the next "<" that starts something other than text.
+func (s *Scanner) text() Token {
+	start := s.pos
+	s.pos++ // the first byte is text, even if it is "<".
+	for s.pos < len(s.src) {
+		ix := strings.IndexByte(s.src[s.pos:], '<')
+		if ix < 0 {
+			s.pos = len(s.src)
+			break
+		}
+		s.pos += ix
+		if s.pos+1 < len(s.src) {
+			switch ch := s.src[s.pos+1]; {
+			case isLetter(ch), ch == '/', ch == '!', ch == '?':
+				return s.textToken(start, true)
+			}
+		}
+		s.pos++
+	}
+	return s.textToken(start, true)
+}
+
+func (s *Scanner) textToken(start int, decode bool) Token {
+	raw := s.src[start:s.pos]
+	data := raw
+	if decode {
+		data = stdhtml.UnescapeString(raw)
+	}
+	return Token{Kind: Text, Data: data, Raw: raw}
+}
+
+// rawText scans the content of a raw text or RCDATA element until its end tag.
+func (s *Scanner) rawText() Token {
+	start := s.pos
+	for {
+		ix := strings.Index(s.src[s.pos:], `</`)
+		if ix < 0 {
+			s.pos = len(s.src)
+			break
+		}
+		s.pos += ix
+		end := s.pos + 2 + len(s.end)
+		if end <= len(s.src) && strings.EqualFold(s.src[s.pos+2:end], s.end) &&
+			(end == len(s.src) || isSpace(s.src[end]) || s.src[end] == '/' || s.src[end] == '>') {
+			break
+		}
+		s.pos += 2
+	}
+	s.end = ``
+	if s.pos == start {
+		return s.endTag()
+	}
+	return s.textToken(start, s.decode)
+}
+
+// comment scans a comment, doctype or bogus comment, skipping skip bytes and ending with the terminator.
+func (s *Scanner) comment(skip int, terminator string) Token {
+	start := s.pos
+	s.pos += skip
+	var data string
+	if ix := strings.Index(s.src[s.pos:], terminator); ix >= 0 {
+		data = s.src[s.pos : s.pos+ix]
+		s.pos += ix + len(terminator)
+	} else {
+		data = s.src[s.pos:]
+		s.pos = len(s.src)
+	}
+	return Token{Kind: Comment, Data: data, Raw: s.src[start:s.pos]}
+}
+
+func (s *Scanner) startTag() Token {
+	start := s.pos
+	s.pos++
+	tok := Token{Kind: StartTag, Name: s.name()}
+	s.attributes(&tok)
+	tok.Raw = s.src[start:s.pos]
+	if elem, ok := whatwg.Lookup(tok.Name); ok && (elem.RawText || elem.RCDATA) && !tok.SelfClosing {
+		s.end = tok.Name
+		s.decode = elem.RCDATA
+	}
+	return tok
+}
+
+func (s *Scanner) endTag() Token {
+	start := s.pos
+	s.pos += 2
+	tok := Token{Kind: EndTag, Name: s.name()}
+	s.attributes(&tok) // the spec allows attributes in end tags, but ignores them.
+	tok.Attributes, tok.SelfClosing = nil, false
+	tok.Raw = s.src[start:s.pos]
+	return tok
+}
+
+// name scans a tag name, which extends until whitespace, "/" or ">".
+func (s *Scanner) name() string {
+	start := s.pos
+	for s.pos < len(s.src) && !isSpace(s.src[s.pos]) && s.src[s.pos] != '/' && s.src[s.pos] != '>' {
+		s.pos++
+	}
+	return s.src[start:s.pos]
+}
+
+// attributes scans attributes until the end of a tag, skipping repeated attributes like browsers do.
+func (s *Scanner) attributes(tok *Token) {
+	for s.pos < len(s.src) {
+		ch := s.src[s.pos]
+		switch {
+		case ch == '>':
+			s.pos++
+			return
+		case isSpace(ch):
+			s.pos++
+			continue
+		case ch == '/':
+			s.pos++
+			if s.pos < len(s.src) && s.src[s.pos] == '>' {
+				tok.SelfClosing = true
+				s.pos++
+				return
+			}
+			continue
+		}
+		attr := s.attribute()
+		if _, dup := tok.Attr(attr.Name); !dup {
+			tok.Attributes = append(tok.Attributes, attr)
+		}
+	}
+}
+
+func (s *Scanner) attribute() Attribute {
+	var attr Attribute
+	start := s.pos
+	s.pos++ // the first character is part of the name, even if it is "=".
+	for s.pos < len(s.src) {
+		ch := s.src[s.pos]
+		if isSpace(ch) || ch == '/' || ch == '>' || ch == '=' {
+			break
+		}
+		s.pos++
+	}
+	attr.Name = s.src[start:s.pos]
+	s.skipSpace()
+	if s.pos >= len(s.src) || s.src[s.pos] != '=' {
+		return attr
+	}
+	s.pos++
+	s.skipSpace()
+	attr.HasValue = true
+	if s.pos >= len(s.src) {
+		return attr
+	}
+	switch quote := s.src[s.pos]; quote {
+	case '"', '\'':
+		s.pos++
+		end := strings.IndexByte(s.src[s.pos:], quote)
+		if end < 0 {
+			end = len(s.src) - s.pos
+		}
+		attr.Value = stdhtml.UnescapeString(s.src[s.pos : s.pos+end])
+		s.pos = min(s.pos+end+1, len(s.src))
+	default:
+		start := s.pos
+		for s.pos < len(s.src) && !isSpace(s.src[s.pos]) && s.src[s.pos] != '>' {
+			s.pos++
+		}
+		attr.Value = stdhtml.UnescapeString(s.src[start:s.pos])
+	}
+	return attr
+}
+
+func (s *Scanner) skipSpace() {
+	for s.pos < len(s.src) && isSpace(s.src[s.pos]) {
+		s.pos++
+	}
+}
+
+func isLetter(ch byte) bool { return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' }
+
+func isSpace(ch byte) bool { return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' || ch == '\f' }
@@ -0,0 +1,213 @@
+package selector
+
+import (
+	"strconv"
+	"strings"
+)
+
+// A List is a parsed selector list, like `h1, h2.title`, which matches elements that match any of its selectors.
+type List []Complex
+
+// A Complex is a parsed complex selector, like `form > input[type=text]`, made of compound selectors joined by
+// combinators.  The last step matches the element itself, and each step before it matches an element related to the
+// one after it.
+type Complex []Step
+
+// A Step is one compound selector in a complex selector, with the combinator that relates it to the step before it.
+type Step struct {
+	Combinator Combinator // Combinator is ignored for the first step.
+	Compound
+}
+
+// A Combinator describes how the elements matched by two steps in a complex selector are related.
+type Combinator byte
+
+const (
+	Descendant        Combinator = ' ' // "a b" matches a "b" inside an "a"
+	Child             Combinator = '>' // "a > b" matches a "b" whose parent is an "a"
+	NextSibling       Combinator = '+' // "a + b" matches a "b" right after an "a"
+	SubsequentSibling Combinator = '~' // "a ~ b" matches a "b" anywhere after an "a" with the same parent
+)
+
+// A Pseudo is a pseudo-class from a selector list, like ":first-child", ":nth-child(2n+1)" or ":not(.hidden)".
+type Pseudo struct {
+	Name      string // Name is the lowercase name of the pseudo-class, without the ":".
+	A, B      int    // A and B are the arguments of the "nth-" pseudo-classes, like 2 and 1 for "2n+1".
+	Selectors List   // Selectors is the argument of ":not", ":is" and ":where".
+}
+
+// ParseList parses a selector list, which extends the compound selectors used by tag.New with "*", the combinators
+// " ", ">", "+" and "~", attribute operators like "^=" and the "i" flag, and the pseudo-classes supported by Pseudo,
+// returning an error that describes the problem if it is not valid.
+func ParseList(src string) (List, error) {
+	s := scanner{src: src, extended: true}
+	list, err := s.list(0)
+	if err == nil && s.pos < len(s.src) {
+		err = s.errorf(`unexpected %q`, s.src[s.pos])
+	}
+	return list, err
+}
+
+// list parses complex selectors separated by commas, until the end of the selector or the end character.
+func (s *scanner) list(end byte) (List, error) {
+	var list List
+	for {
+		complex, err := s.complex(end)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, complex)
+		if s.pos >= len(s.src) || s.src[s.pos] != ',' {
+			return list, nil
+		}
+		s.pos++
+	}
+}
+
+func (s *scanner) complex(end byte) (Complex, error) {
+	var steps Complex
+	combinator := Descendant
+	explicit := false // true if the combinator was written, instead of implied by whitespace
+	for {
+		spaced := s.skipSpace()
+		if s.pos >= len(s.src) || s.src[s.pos] == ',' || end != 0 && s.src[s.pos] == end {
+			break
+		}
+		switch ch := s.src[s.pos]; ch {
+		case '>', '+', '~':
+			if len(steps) == 0 || explicit {
+				return nil, s.errorf(`expected a selector before %q`, ch)
+			}
+			combinator, explicit = Combinator(ch), true
+			s.pos++
+			continue
+		}
+		if len(steps) > 0 && !spaced && !explicit {
+			return nil, s.errorf(`unexpected %q`, s.src[s.pos])
+		}
+		start := s.pos
+		compound, err := s.compound()
+		switch {
+		case err != nil:
+			return nil, err
+		case s.pos == start:
+			return nil, s.errorf(`unexpected %q`, s.src[s.pos])
+		}
+		steps = append(steps, Step{Combinator: combinator, Compound: compound})
+		combinator, explicit = Descendant, false
+	}
+	if len(steps) == 0 || explicit {
+		return nil, s.errorf(`expected a selector`)
+	}
+	return steps, nil
+}
+
+// skipSpace skips whitespace, returning true if there was any.
+func (s *scanner) skipSpace() bool {
+	start := s.pos
+	for s.pos < len(s.src) && isSpace(s.src[s.pos]) {
+		s.pos++
+	}
+	return s.pos > start
+}
+
+// flags parses the flag that can follow an attribute value, like the " i" in "[type=text i]".
+func (s *scanner) flags(attr *Attribute) {
+	s.skipSpace()
+	if s.pos < len(s.src) {
+		switch s.src[s.pos] {
+		case 'i', 'I':
+			attr.IgnoreCase = true
+			s.pos++
+		case 's', 'S':
+			s.pos++
+		}
+	}
+	s.skipSpace()
+}
+
+// pseudo parses a pseudo-class after the ":".
+func (s *scanner) pseudo() (Pseudo, error) {
+	pseudo := Pseudo{Name: strings.ToLower(s.ident())}
+	switch pseudo.Name {
+	case `first-child`, `last-child`, `only-child`, `first-of-type`, `last-of-type`, `only-of-type`, `empty`, `root`,
+		`checked`, `disabled`, `enabled`:
+		return pseudo, nil
+	case `nth-child`, `nth-last-child`, `nth-of-type`, `nth-last-of-type`:
+		if err := s.open(pseudo.Name); err != nil {
+			return pseudo, err
+		}
+		end := strings.IndexByte(s.src[s.pos:], ')')
+		if end < 0 {
+			return pseudo, s.errorf(`expected ")" to end :%v`, pseudo.Name)
+		}
+		var ok bool
+		pseudo.A, pseudo.B, ok = parseNth(s.src[s.pos : s.pos+end])
+		if !ok {
+			return pseudo, s.errorf(`%q is not a valid argument for :%v`, s.src[s.pos:s.pos+end], pseudo.Name)
+		}
+		s.pos += end + 1
+		return pseudo, nil
+	case `not`, `is`, `where`:
+		if err := s.open(pseudo.Name); err != nil {
+			return pseudo, err
+		}
+		list, err := s.list(')')
+		if err != nil {
+			return pseudo, err
+		}
+		if s.pos >= len(s.src) || s.src[s.pos] != ')' {
+			return pseudo, s.errorf(`expected ")" to end :%v`, pseudo.Name)
+		}
+		s.pos++
+		pseudo.Selectors = list
+		return pseudo, nil
+	case ``:
+		return pseudo, s.errorf(`expected a pseudo-class after ":"`)
+	}
+	return pseudo, s.errorf(`:%v is not a supported pseudo-class`, pseudo.Name)
+}
+
+// open skips the "(" after the name of a pseudo-class that needs an argument.
+func (s *scanner) open(name string) error {
+	if s.pos >= len(s.src) || s.src[s.pos] != '(' {
+		return s.errorf(`expected "(" after :%v`, name)
+	}
+	s.pos++
+	return nil
+}
+
+// parseNth parses the "An+B" argument of pseudo-classes like ":nth-child", including "odd" and "even".
+func parseNth(arg string) (a, b int, ok bool) {
+	arg = strings.ToLower(strings.Join(strings.Fields(arg), ``))
+	switch arg {
+	case `odd`:
+		return 2, 1, true
+	case `even`:
+		return 2, 0, true
+	}
+	head, tail, found := strings.Cut(arg, `n`)
+	if !found {
+		b, err := strconv.Atoi(arg)
+		return 0, b, err == nil
+	}
+	switch head {
+	case ``, `+`:
+		a = 1
+	case `-`:
+		a = -1
+	default:
+		var err error
+		if a, err = strconv.Atoi(head); err != nil {
+			return 0, 0, false
+		}
+	}
+	if tail == `` {
+		return a, 0, true
+	}
+	if tail[0] != '+' && tail[0] != '-' {
+		return 0, 0, false
+	}
+	b, err := strconv.Atoi(tail)
+	return a, b, err == nil
+}
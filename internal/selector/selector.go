@@ -0,0 +1,297 @@
+// Package selector parses the CSS-like selectors used by tag.New, like `input#name.wide[type=text][required]`.
+package selector
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/swdunlop/html-go"
+)
+
+// A Compound is a parsed compound selector, combining an optional element name with an ID, classes and attributes.
+type Compound struct {
+	Name       string      // Name is the element name, or an empty string if it was omitted.
+	ID         string      // ID is the element ID, or an empty string if it was omitted.
+	Classes    []string    // Classes lists each class in the order they appeared.
+	Attributes []Attribute // Attributes lists each attribute other than id and class.
+	Pseudo     []Pseudo    // Pseudo lists each pseudo-class, like ":first-child", which only selector lists allow.
+}
+
+// An Attribute is an attribute from a selector, like "[required]", "[type=text]" or "[title='Hello, World']".
+type Attribute struct {
+	Name       string // Name is the name of the attribute.
+	Value      string // Value is the unquoted value of the attribute.
+	HasValue   bool   // HasValue is false for boolean attributes like "[required]".
+	Match      byte   // Match is the character before "=" in a selector list, like '^' for "[href^=https]", or zero.
+	IgnoreCase bool   // IgnoreCase is true if the value is followed by " i", like "[type=TEXT i]".
+}
+
+// Parse parses a compound selector, returning an error that describes the problem if it is not valid.
+func Parse(src string) (Compound, error) {
+	s := scanner{src: src}
+	compound, err := s.compound()
+	if err == nil && s.pos < len(s.src) {
+		err = s.errorf(`unexpected %q`, s.src[s.pos])
+	}
+	return compound, err
+}
+
+type scanner struct {
+	src      string
+	pos      int
+	extended bool // true when parsing a selector list, which allows combinators, "*", ":" and attribute operators
+}
+
+func (s *scanner) errorf(format string, args ...any) error {
+	return fmt.Errorf(`invalid selector %q at offset %v: %v`, s.src, s.pos, fmt.Sprintf(format, args...))
+}
+
+func (s *scanner) compound() (Compound, error) {
+	var compound Compound
+	if s.extended && s.pos < len(s.src) && s.src[s.pos] == '*' {
+		s.pos++ // the universal selector matches any element, like an empty name.
+	} else if s.pos < len(s.src) && !s.delimiter(s.src[s.pos]) {
+		name := s.ident()
+		if !ValidName(name) {
+			return compound, s.errorf(`%q is not a valid element name`, name)
+		}
+		compound.Name = name
+	}
+	for s.pos < len(s.src) {
+		switch s.src[s.pos] {
+		case '#':
+			s.pos++
+			id := s.ident()
+			switch {
+			case id == ``:
+				return compound, s.errorf(`expected an ID after "#"`)
+			case compound.ID != ``:
+				return compound, s.errorf(`the ID is specified more than once`)
+			}
+			compound.ID = id
+		case '.':
+			s.pos++
+			class := s.ident()
+			if class == `` {
+				return compound, s.errorf(`expected a class after "."`)
+			}
+			compound.Classes = append(compound.Classes, class)
+		case '[':
+			s.pos++
+			attr, err := s.attribute()
+			if err != nil {
+				return compound, err
+			}
+			// in a selector list, "[id^=a]" or "[class]" are matched like any other attribute.
+			plain := attr.Match == 0 && !attr.IgnoreCase && (attr.HasValue || !s.extended)
+			switch {
+			case plain && attr.Name == `id`:
+				if compound.ID != `` {
+					return compound, s.errorf(`the ID is specified more than once`)
+				}
+				if !validIdent(attr.Value) {
+					return compound, s.errorf(`%q is not a valid ID`, attr.Value)
+				}
+				compound.ID = attr.Value
+			case plain && attr.Name == `class`:
+				compound.Classes = append(compound.Classes, strings.Fields(attr.Value)...)
+			default:
+				compound.Attributes = append(compound.Attributes, attr)
+			}
+		case ':':
+			if !s.extended {
+				return compound, nil
+			}
+			s.pos++
+			pseudo, err := s.pseudo()
+			if err != nil {
+				return compound, err
+			}
+			compound.Pseudo = append(compound.Pseudo, pseudo)
+		default:
+			return compound, nil
+		}
+	}
+	return compound, nil
+}
+
+// attribute parses an attribute after the opening "[".
+func (s *scanner) attribute() (Attribute, error) {
+	var attr Attribute
+	end := strings.IndexAny(s.src[s.pos:], `=]`)
+	if end < 0 {
+		return attr, s.errorf(`expected "]" to end the attribute`)
+	}
+	attr.Name = s.src[s.pos : s.pos+end]
+	if s.extended && attr.Name != `` && strings.ContainsRune(`~|^$*`, rune(attr.Name[len(attr.Name)-1])) {
+		attr.Match = attr.Name[len(attr.Name)-1]
+		attr.Name = attr.Name[:len(attr.Name)-1]
+	}
+	if attr.Name == `` {
+		return attr, s.errorf(`expected an attribute name after "["`)
+	}
+	if !html.ValidAttrName(attr.Name) {
+		return attr, s.errorf(`%q is not a valid attribute name`, attr.Name)
+	}
+	s.pos += end
+	if s.src[s.pos] == ']' {
+		s.pos++
+		return attr, nil
+	}
+	s.pos++ // skip "="
+	attr.HasValue = true
+	var err error
+	if s.pos < len(s.src) && (s.src[s.pos] == '"' || s.src[s.pos] == '\'') {
+		attr.Value, err = s.quoted()
+	} else {
+		attr.Value, err = s.unquoted()
+	}
+	if err != nil {
+		return attr, err
+	}
+	if s.extended {
+		s.flags(&attr)
+	}
+	if s.pos >= len(s.src) || s.src[s.pos] != ']' {
+		return attr, s.errorf(`expected "]" to end the attribute`)
+	}
+	s.pos++
+	return attr, nil
+}
+
+// quoted scans a CSS string, which starts and ends with the same quote and may contain escapes.
+func (s *scanner) quoted() (string, error) {
+	quote := s.src[s.pos]
+	s.pos++
+	var buf strings.Builder
+	for s.pos < len(s.src) {
+		ch := s.src[s.pos]
+		switch ch {
+		case quote:
+			s.pos++
+			return buf.String(), nil
+		case '\\':
+			s.escape(&buf)
+		case '\n', '\r', '\f':
+			return ``, s.errorf(`unescaped newline in quoted attribute value`)
+		default:
+			buf.WriteByte(ch)
+			s.pos++
+		}
+	}
+	return ``, s.errorf(`expected %c to end the attribute value`, quote)
+}
+
+// unquoted scans an unquoted attribute value, which extends until "]" and may contain escapes, but not spaces or
+// quotes.
+func (s *scanner) unquoted() (string, error) {
+	var buf strings.Builder
+	for s.pos < len(s.src) {
+		ch := s.src[s.pos]
+		switch {
+		case ch == ']':
+			return buf.String(), nil
+		case ch == '\\':
+			s.escape(&buf)
+		case isSpace(ch) && s.extended:
+			return buf.String(), nil
+		case isSpace(ch), ch == '"', ch == '\'':
+			return ``, s.errorf(`unquoted attribute values cannot contain spaces or quotes, try quoting the value`)
+		default:
+			buf.WriteByte(ch)
+			s.pos++
+		}
+	}
+	return buf.String(), nil
+}
+
+// escape scans a CSS escape starting with a backslash: either one to six hex digits, optionally followed by a space,
+// an escaped newline, which is ignored, or any other character, which is taken literally.
+func (s *scanner) escape(buf *strings.Builder) {
+	s.pos++ // skip "\"
+	if s.pos >= len(s.src) {
+		buf.WriteRune(utf8.RuneError) // CSS replaces a backslash at the end of input.
+		return
+	}
+	n := 0
+	for n < 6 && s.pos+n < len(s.src) && isHex(s.src[s.pos+n]) {
+		n++
+	}
+	if n == 0 {
+		switch s.src[s.pos] {
+		case '\n', '\f':
+			s.pos++
+		case '\r':
+			s.pos++
+			if s.pos < len(s.src) && s.src[s.pos] == '\n' {
+				s.pos++
+			}
+		default:
+			ch, size := utf8.DecodeRuneInString(s.src[s.pos:])
+			buf.WriteRune(ch)
+			s.pos += size
+		}
+		return
+	}
+	code, _ := strconv.ParseUint(s.src[s.pos:s.pos+n], 16, 32)
+	s.pos += n
+	if s.pos < len(s.src) && isSpace(s.src[s.pos]) {
+		s.pos++
+	}
+	ch := rune(code)
+	if ch == 0 || !utf8.ValidRune(ch) {
+		ch = utf8.RuneError
+	}
+	buf.WriteRune(ch)
+}
+
+func isHex(ch byte) bool {
+	return '0' <= ch && ch <= '9' || 'a' <= ch && ch <= 'f' || 'A' <= ch && ch <= 'F'
+}
+
+// ident scans an element name, ID or class, which extends until the next delimiter.
+func (s *scanner) ident() string {
+	start := s.pos
+	for s.pos < len(s.src) && !s.delimiter(s.src[s.pos]) && !isSpace(s.src[s.pos]) {
+		s.pos++
+	}
+	return s.src[start:s.pos]
+}
+
+// delimiter returns true if the character ends an element name, ID or class.
+func (s *scanner) delimiter(ch byte) bool {
+	switch ch {
+	case '#', '.', '[', ']':
+		return true
+	case ':', '>', '+', '~', ',', '(', ')':
+		return s.extended
+	}
+	return false
+}
+
+func isSpace(ch byte) bool { return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' || ch == '\f' }
+
+// ValidName returns true if the name is a valid element name: an ASCII letter followed by ASCII letters, digits,
+// hyphens or underscores.
+func ValidName(name string) bool {
+	if name == `` {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		ch := name[i]
+		switch {
+		case 'a' <= ch && ch <= 'z', 'A' <= ch && ch <= 'Z':
+		case i > 0 && ('0' <= ch && ch <= '9' || ch == '-' || ch == '_'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// validIdent returns true if the text can be used as an ID or class, which must not be empty or contain spaces.
+func validIdent(text string) bool {
+	return text != `` && !strings.ContainsAny(text, " \t\r\n\f")
+}
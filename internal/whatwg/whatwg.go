@@ -0,0 +1,241 @@
+// Package whatwg describes the elements of the WHATWG HTML Living Standard and the attributes they accept, for use by
+// packages that generate, render or check HTML.  The table is kept in elements.txt, which is easier to compare with
+// the spec than Go source.
+package whatwg
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+)
+
+// An Element describes an HTML element.
+type Element struct {
+	Name        string      // Name is the lowercase name of the element, like "input".
+	Void        bool        // Void elements, like "br", have no content and no end tag.
+	RawText     bool        // RawText elements, "script" and "style", have content that is not escaped.
+	RCDATA      bool        // RCDATA elements, "textarea" and "title", can only contain escaped text.
+	Inline      bool        // Inline elements are phrasing content that is normally rendered inline with text.
+	Interactive bool        // Interactive elements, like "a" and "button", cannot contain other interactive elements.
+	Foreign     bool        // Foreign elements, "svg" and "math", are from SVG and MathML.
+	Obsolete    bool        // Obsolete elements are still parsed by browsers, but should not be used.
+	Attributes  []Attribute // Attributes lists the attributes specific to the element, not including global ones.
+}
+
+// An Attribute describes an attribute of an HTML element.
+type Attribute struct {
+	Name     string // Name is the name of the attribute, like "maxlength".
+	GoName   string // GoName is the name of the attribute as a Go identifier, like "MaxLength".
+	Type     Type   // Type describes the values accepted by the attribute.
+	Required bool   // Required attributes must be present on the element.
+}
+
+// A Type describes the kind of value accepted by an attribute.
+type Type int
+
+const (
+	String  Type = iota // any string, including enumerated values and URLs
+	Boolean             // present or absent, without a value
+	Integer             // an integer
+	Number              // a floating point number
+)
+
+// Lookup returns the element with the provided name, ignoring ASCII case.
+func Lookup(name string) (*Element, bool) {
+	elem, ok := index[name]
+	if !ok {
+		elem, ok = index[strings.ToLower(name)]
+	}
+	return elem, ok
+}
+
+// Elements returns every element in the table, in order by name, followed by foreign and obsolete elements.
+func Elements() []*Element { return elements }
+
+// Globals returns the global attributes that are commonly set on any element, not including "id", "class", "style",
+// event handlers and "data-" or "aria-" attributes, which have their own helpers.
+func Globals() []Attribute { return globals }
+
+// Attribute returns the element specific or global attribute with the provided name.
+func (elem *Element) Attribute(name string) (Attribute, bool) {
+	for _, attr := range elem.Attributes {
+		if attr.Name == name {
+			return attr, true
+		}
+	}
+	for _, attr := range globals {
+		if attr.Name == name {
+			return attr, true
+		}
+	}
+	return Attribute{}, false
+}
+
+//go:embed elements.txt
+var table string
+
+var (
+	elements []*Element
+	globals  []Attribute
+	index    = make(map[string]*Element)
+)
+
+func init() {
+	for i, line := range strings.Split(table, "\n") {
+		if err := parseLine(line); err != nil {
+			panic(fmt.Errorf(`elements.txt:%v: %w`, i+1, err))
+		}
+	}
+}
+
+func parseLine(line string) error {
+	line = strings.TrimSpace(line)
+	if line == `` || line[0] == '#' {
+		return nil
+	}
+	head, tail, ok := strings.Cut(line, `:`)
+	if !ok {
+		return fmt.Errorf(`expected ":" after the element name and flags`)
+	}
+	var attrs []Attribute
+	for _, field := range strings.Fields(tail) {
+		attr, err := parseAttribute(field)
+		if err != nil {
+			return err
+		}
+		attrs = append(attrs, attr)
+	}
+	fields := strings.Fields(head)
+	if len(fields) == 0 {
+		return fmt.Errorf(`expected an element name`)
+	}
+	if fields[0] == `*` {
+		globals = attrs
+		return nil
+	}
+	elem := &Element{Name: fields[0], Attributes: attrs}
+	for _, flag := range fields[1:] {
+		switch flag {
+		case `void`:
+			elem.Void = true
+		case `raw`:
+			elem.RawText = true
+		case `rcdata`:
+			elem.RCDATA = true
+		case `inline`:
+			elem.Inline = true
+		case `interactive`:
+			elem.Interactive = true
+		case `foreign`:
+			elem.Foreign = true
+		case `obsolete`:
+			elem.Obsolete = true
+		default:
+			return fmt.Errorf(`unknown flag %q for %q`, flag, elem.Name)
+		}
+	}
+	if index[elem.Name] != nil {
+		return fmt.Errorf(`%q is listed more than once`, elem.Name)
+	}
+	elements = append(elements, elem)
+	index[elem.Name] = elem
+	return nil
+}
+
+func parseAttribute(field string) (Attribute, error) {
+	var attr Attribute
+	switch field[len(field)-1] {
+	case '?':
+		attr.Type = Boolean
+	case '#':
+		attr.Type = Integer
+	case '%':
+		attr.Type = Number
+	case '!':
+		attr.Required = true
+	}
+	field = strings.TrimRight(field, `?#%!`)
+	attr.Name, attr.GoName, _ = strings.Cut(field, `/`)
+	if attr.Name == `` {
+		return attr, fmt.Errorf(`expected an attribute name`)
+	}
+	if attr.GoName == `` {
+		attr.GoName = strings.ToUpper(attr.Name[:1]) + attr.Name[1:]
+	}
+	return attr, nil
+}
+
+// EndsParagraph returns true if a start tag for the element ends an open "p" element, so the element cannot be inside
+// a paragraph, and the end tag of a paragraph can be omitted before it.
+func EndsParagraph(name string) bool { return endsParagraph[name] }
+
+// OptionalEnd returns true if the end tag of the element can be omitted in some circumstances, like "li".
+func OptionalEnd(name string) bool { return optionalEnd[name] }
+
+// An ImpliedEnd describes end tags that a start tag implies, like the end of an open "li" before another "li".
+type ImpliedEnd struct {
+	// Names lists the elements that are closed, along with any elements open inside them.
+	Names []string
+
+	// Boundaries lists the elements that stop the search for an open element with one of the names, in addition to
+	// the elements that limit the scope of every implied end; see Scope.
+	Boundaries []string
+
+	// Current is true if only the innermost open element is closed, and only if it has one of the names.
+	Current bool
+}
+
+// ImpliedEnds returns the end tags implied by a start tag for the element, in the order browsers apply them, so code
+// that parses or checks HTML can close elements the way browsers do when optional end tags are left out.
+func ImpliedEnds(name string) []ImpliedEnd { return impliedEnds[name] }
+
+var impliedEnds = func() map[string][]ImpliedEnd {
+	cells := ImpliedEnd{Names: []string{`td`, `th`}, Boundaries: []string{`tr`, `table`}}
+	rows := ImpliedEnd{Names: []string{`tr`}, Boundaries: []string{`tbody`, `thead`, `tfoot`}}
+	headings := ImpliedEnd{Names: []string{`h1`, `h2`, `h3`, `h4`, `h5`, `h6`}, Current: true}
+	ends := map[string][]ImpliedEnd{
+		`li`:       {{Names: []string{`li`}, Boundaries: []string{`ul`, `ol`, `menu`}}},
+		`dt`:       {{Names: []string{`dt`, `dd`}, Boundaries: []string{`dl`}}},
+		`dd`:       {{Names: []string{`dt`, `dd`}, Boundaries: []string{`dl`}}},
+		`option`:   {{Names: []string{`option`}, Current: true}},
+		`optgroup`: {{Names: []string{`option`}, Current: true}, {Names: []string{`optgroup`}, Current: true}},
+		`tr`:       {cells, rows},
+		`td`:       {{Names: []string{`td`, `th`}, Boundaries: []string{`tr`, `tbody`, `thead`, `tfoot`}}},
+		`th`:       {{Names: []string{`td`, `th`}, Boundaries: []string{`tr`, `tbody`, `thead`, `tfoot`}}},
+		`rt`:       {{Names: []string{`rt`, `rp`}, Boundaries: []string{`ruby`}}},
+		`rp`:       {{Names: []string{`rt`, `rp`}, Boundaries: []string{`ruby`}}},
+		`body`:     {{Names: []string{`head`}, Boundaries: []string{`html`}}},
+	}
+	for _, name := range []string{`tbody`, `thead`, `tfoot`} {
+		ends[name] = []ImpliedEnd{cells, rows, {Names: []string{`tbody`, `thead`, `tfoot`}}}
+	}
+	for _, name := range headings.Names {
+		ends[name] = []ImpliedEnd{headings}
+	}
+	paragraph := ImpliedEnd{Names: []string{`p`}, Boundaries: []string{`button`}}
+	for name := range endsParagraph {
+		ends[name] = append([]ImpliedEnd{paragraph}, ends[name]...)
+	}
+	return ends
+}()
+
+// Scope returns true if the element limits the scope of implied end tags, like "table", so an implied end never
+// closes an element that is open outside of it.
+func Scope(name string) bool { return scopes[name] }
+
+var scopes = setOf(`html`, `table`, `td`, `th`, `caption`, `template`, `object`, `marquee`, `applet`, `svg`, `math`)
+
+var endsParagraph = setOf(`address`, `article`, `aside`, `blockquote`, `details`, `dialog`, `div`, `dl`, `dd`, `dt`,
+	`fieldset`, `figcaption`, `figure`, `footer`, `form`, `h1`, `h2`, `h3`, `h4`, `h5`, `h6`, `header`, `hgroup`, `hr`,
+	`li`, `main`, `menu`, `nav`, `ol`, `p`, `pre`, `search`, `section`, `table`, `ul`)
+
+var optionalEnd = setOf(`html`, `head`, `body`, `li`, `dt`, `dd`, `p`, `rt`, `rp`, `optgroup`, `option`, `colgroup`,
+	`caption`, `thead`, `tbody`, `tfoot`, `tr`, `td`, `th`)
+
+func setOf(names ...string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
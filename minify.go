@@ -0,0 +1,17 @@
+package html
+
+import "context"
+
+// Minify returns a context that asks content to render as compactly as it can, for use with AppendContext,
+// WriteToContext or NewWriterContext.  Tags from the tag package collapse insignificant whitespace in their text,
+// drop end tags that the HTML spec allows to be omitted, like "</li>" before another "li", and leave simple
+// attribute values unquoted.  Other content, like HTML, is not changed.
+func Minify(ctx context.Context) context.Context { return context.WithValue(ctx, minifyKey{}, true) }
+
+// Minified returns true if content rendered with ctx should be minified; see Minify.
+func Minified(ctx context.Context) bool {
+	minified, _ := ctx.Value(minifyKey{}).(bool)
+	return minified
+}
+
+type minifyKey struct{}
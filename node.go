@@ -0,0 +1,93 @@
+package html
+
+import (
+	"context"
+	"iter"
+	"slices"
+)
+
+// A Node is Content that can be inspected and rebuilt, which lets code like middleware walk a page before it is
+// rendered.  Tags from the tag package, Group and Text implement Node.
+type Node interface {
+	Content
+
+	// TagName returns the name of an element, like "div", an empty string for a Group, or "#text" for Text.
+	TagName() string
+
+	// Attributes iterates over the name and value of each attribute of an element.
+	Attributes() iter.Seq2[string, string]
+
+	// Children returns the content of an element or Group.
+	Children() []Content
+
+	// WithChildren returns a copy of the node with its content replaced.
+	WithChildren(children ...Content) Node
+}
+
+// TagName implements Node by returning an empty string, since a Group is not an element.
+func (group Group) TagName() string { return `` }
+
+// Attributes implements Node with no attributes.
+func (group Group) Attributes() iter.Seq2[string, string] { return noAttributes }
+
+// Children implements Node by returning a copy of the content of the group.
+func (group Group) Children() []Content { return slices.Clone([]Content(group)) }
+
+// WithChildren implements Node by returning a new group with the provided content.
+func (group Group) WithChildren(children ...Content) Node { return Group(children) }
+
+// TagName implements Node by returning "#text", like the nodeName of a DOM text node.
+func (text Text) TagName() string { return `#text` }
+
+// Attributes implements Node with no attributes.
+func (text Text) Attributes() iter.Seq2[string, string] { return noAttributes }
+
+// Children implements Node with no children.
+func (text Text) Children() []Content { return nil }
+
+// WithChildren implements Node by returning the text unchanged, since text cannot have children.
+func (text Text) WithChildren(children ...Content) Node { return text }
+
+func noAttributes(yield func(string, string) bool) {}
+
+// Walk calls fn for each Node in the content, visiting each node before its children in the order they would be
+// rendered.  If fn returns false, the children of that node are skipped.  Walk calls a Func to walk the content it
+// returns, and a ContextFunc with context.Background(); other content is skipped.
+func Walk(content Content, fn func(Node) bool) {
+	switch content := content.(type) {
+	case Func:
+		Walk(content(), fn)
+	case ContextFunc:
+		Walk(content(context.Background()), fn)
+	case Node:
+		if !fn(content) {
+			return
+		}
+		for _, child := range content.Children() {
+			Walk(child, fn)
+		}
+	}
+}
+
+// Transform returns a copy of the content with each Node replaced by the content fn returns for it.  Nodes are
+// visited after their children, so fn is given a node whose children have already been transformed.  Content that is
+// not a Node, like HTML, is kept as it is, except that Func and ContextFunc are wrapped so the content they return is
+// transformed when it is rendered.
+func Transform(content Content, fn func(Node) Content) Content {
+	switch content := content.(type) {
+	case Func:
+		return Func(func() Content { return Transform(content(), fn) })
+	case ContextFunc:
+		return ContextFunc(func(ctx context.Context) Content { return Transform(content(ctx), fn) })
+	case Node:
+		if children := content.Children(); len(children) > 0 {
+			transformed := make([]Content, len(children))
+			for i, child := range children {
+				transformed[i] = Transform(child, fn)
+			}
+			content = content.WithChildren(transformed...)
+		}
+		return fn(content)
+	}
+	return content
+}
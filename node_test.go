@@ -0,0 +1,87 @@
+package html_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/el"
+	"github.com/swdunlop/html-go/tag"
+)
+
+func TestWalk(t *testing.T) {
+	page := html.Group{
+		tag.New(`main#main`).Add(
+			tag.New(`h1#title`).Text(`Title`),
+			html.Func(func() html.Content { return tag.New(`p#lazy`).Text(`Lazy`) }),
+			el.Section(tag.New(`div#inner`)).Set(`id`, `section`),
+			html.HTML(`<p id="opaque"></p>`),
+		),
+	}
+	var ids []string
+	html.Walk(page, func(n html.Node) bool {
+		for name, value := range n.Attributes() {
+			if name == `id` {
+				ids = append(ids, value)
+			}
+		}
+		return n.TagName() != `section`
+	})
+	if expect := []string{`main`, `title`, `lazy`, `section`}; !slices.Equal(ids, expect) {
+		t.Errorf("expected %q, got %q", expect, ids)
+	}
+}
+
+func TestGroupChildren(t *testing.T) {
+	group := html.Group{html.Text(`a`), html.Text(`b`)}
+	children := group.Children()
+	children[0] = html.Text(`c`)
+	if got := string(group.AppendHTML(nil)); got != `ab` {
+		t.Errorf("expected changing the children to leave the group alone, got %q", got)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	page := html.Group{
+		tag.New(`form#a`).Add(tag.New(`input[name=x]`)),
+		tag.New(`div`).Add(
+			html.Func(func() html.Content { return tag.New(`form#b`) }),
+			tag.New(`script`).Text(`go()`),
+		),
+	}
+	result := html.Transform(page, func(n html.Node) html.Content {
+		elem, ok := n.(tag.Interface)
+		switch {
+		case !ok:
+			return n
+		case elem.TagName() == `form`:
+			return elem.Add(tag.New(`input[type=hidden][name=csrf]`).Set(`value`, `token`))
+		case elem.TagName() == `script`:
+			return elem.Set(`nonce`, `n0nce`)
+		}
+		return n
+	})
+	expect := `<form id='a'><input name='x'><input type='hidden' name='csrf' value='token'></form>` +
+		`<div><form id='b'><input type='hidden' name='csrf' value='token'></form>` +
+		`<script nonce='n0nce'>go()</script></div>`
+	if got := string(result.AppendHTML(nil)); got != expect {
+		t.Errorf("expected %q, got %q", expect, got)
+	}
+	if got := string(page.AppendHTML(nil)); got == expect {
+		t.Errorf("expected the original content to be unchanged")
+	}
+}
+
+func TestTransformElements(t *testing.T) {
+	page := el.Div(el.Script().Src(`/app.js`), el.Script(html.JS(`go()`)))
+	result := html.Transform(page, func(n html.Node) html.Content {
+		if elem, ok := n.(tag.Interface); ok && elem.TagName() == `script` {
+			return elem.Set(`nonce`, `n0nce`)
+		}
+		return n
+	})
+	expect := `<div><script src='/app.js' nonce='n0nce'></script><script nonce='n0nce'>go()</script></div>`
+	if got := string(result.AppendHTML(nil)); got != expect {
+		t.Errorf("expected %q, got %q", expect, got)
+	}
+}
@@ -0,0 +1,282 @@
+// Package page builds the shell of an HTML document -- the doctype, "html", "head" and "body" -- so handlers only
+// need to provide a title and the content of the body:
+//
+//	page.New(`Users`).
+//		Stylesheet(page.Stylesheet{Href: `/static/app.css`}).
+//		Script(page.Script{Src: `https://unpkg.com/htmx.org@1.9.2`, Integrity: `sha384-...`, Defer: true}).
+//		Body(userTable)
+//
+// Content in the body can use html.Require to declare the assets it needs, like the stylesheet for a widget, which
+// the document adds to its head once, no matter how many times the widget appears.
+//
+// Like tags, each method of a Document returns a copy, so a site can keep a base document with its stylesheets and
+// scripts in a package variable and add the title and body for each request.
+package page
+
+import (
+	"context"
+	"iter"
+	"slices"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+)
+
+// A Document is a complete HTML document, which implements html.Content.  The zero value is an empty document in
+// English with no title.
+type Document struct {
+	lang        string
+	title       string
+	meta        []meta
+	stylesheets []Stylesheet
+	scripts     []Script
+	head        []html.Content
+	body        []html.Content
+}
+
+// New returns a document with a title, using "en" as its language and the usual viewport for mobile browsers.
+func New(title string) Document {
+	return Document{title: title}.Meta(`viewport`, `width=device-width, initial-scale=1`)
+}
+
+// Lang returns a copy of the document with the language of its content, like "en" or "fr-CA".
+func (doc Document) Lang(lang string) Document {
+	doc.lang = lang
+	return doc
+}
+
+// Title returns a copy of the document with a title.
+func (doc Document) Title(title string) Document {
+	doc.title = title
+	return doc
+}
+
+// Meta returns a copy of the document with a "meta" tag in the head, like Meta("description", "..."), replacing any
+// previous "meta" tag with the same name, like the default viewport.
+func (doc Document) Meta(name, content string) Document {
+	i := slices.IndexFunc(doc.meta, func(m meta) bool { return m.name == name })
+	doc.meta = slices.Clone(doc.meta)
+	if i < 0 {
+		doc.meta = append(doc.meta, meta{name: name, content: content})
+	} else {
+		doc.meta[i].content = content
+	}
+	return doc
+}
+
+type meta struct {
+	name, content string
+}
+
+// A Stylesheet is a stylesheet linked from the head of a document.
+type Stylesheet struct {
+	Href      html.URL // Href is the trusted URL of the stylesheet; use html.SafeURL for an untrusted URL.
+	Integrity string   // Integrity is an optional Subresource Integrity hash, like "sha384-...".
+	Media     string   // Media is an optional media query, like "print".
+}
+
+// A Script is a script loaded in the head of a document.
+type Script struct {
+	Src       html.URL // Src is the trusted URL of the script; use html.SafeURL for an untrusted URL.
+	Integrity string   // Integrity is an optional Subresource Integrity hash, like "sha384-...".
+	Module    bool     // Module scripts are loaded with type="module", which also defers them.
+	Defer     bool     // Defer runs the script after the document has been parsed.
+	Async     bool     // Async runs the script as soon as it has loaded.
+}
+
+// AssetKey implements html.Asset, identifying the stylesheet by its URL.
+func (s Stylesheet) AssetKey() string { return string(s.Href) }
+
+// AppendHTML implements html.Content by appending a "link" tag for the stylesheet.
+func (s Stylesheet) AppendHTML(buf []byte) []byte { return s.tag().AppendHTML(buf) }
+
+// AppendHTMLContext implements html.ContextContent by appending a "link" tag for the stylesheet, minified if ctx
+// asks for it.
+func (s Stylesheet) AppendHTMLContext(ctx context.Context, buf []byte) []byte {
+	return s.tag().AppendHTMLContext(ctx, buf)
+}
+
+func (s Stylesheet) tag() tag.Interface {
+	t := stylesheet.Set(`href`, s.Href)
+	if s.Media != `` {
+		t = t.Set(`media`, s.Media)
+	}
+	return withIntegrity(t, s.Integrity)
+}
+
+// AssetKey implements html.Asset, identifying the script by its URL.
+func (s Script) AssetKey() string { return string(s.Src) }
+
+// AppendHTML implements html.Content by appending a "script" tag for the script.
+func (s Script) AppendHTML(buf []byte) []byte { return s.tag().AppendHTML(buf) }
+
+// AppendHTMLContext implements html.ContextContent by appending a "script" tag for the script, minified if ctx asks
+// for it.
+func (s Script) AppendHTMLContext(ctx context.Context, buf []byte) []byte {
+	return s.tag().AppendHTMLContext(ctx, buf)
+}
+
+func (s Script) tag() tag.Interface {
+	t := script.Set(`src`, s.Src)
+	if s.Module {
+		t = t.Set(`type`, `module`)
+	}
+	return withIntegrity(t.Toggle(`defer`, s.Defer).Toggle(`async`, s.Async), s.Integrity)
+}
+
+// Stylesheet returns a copy of the document with stylesheets added to its head, in order, skipping any stylesheet
+// whose URL was already added, so shared layouts and pages can each ask for the stylesheets they need.
+func (doc Document) Stylesheet(stylesheets ...Stylesheet) Document {
+	doc.stylesheets = slices.Clone(doc.stylesheets)
+	for _, stylesheet := range stylesheets {
+		if !slices.ContainsFunc(doc.stylesheets, func(s Stylesheet) bool { return s.Href == stylesheet.Href }) {
+			doc.stylesheets = append(doc.stylesheets, stylesheet)
+		}
+	}
+	return doc
+}
+
+// Script returns a copy of the document with scripts added to its head, in order, skipping any script whose URL
+// was already added.
+func (doc Document) Script(scripts ...Script) Document {
+	doc.scripts = slices.Clone(doc.scripts)
+	for _, script := range scripts {
+		if !slices.ContainsFunc(doc.scripts, func(s Script) bool { return s.Src == script.Src }) {
+			doc.scripts = append(doc.scripts, script)
+		}
+	}
+	return doc
+}
+
+// Head returns a copy of the document with content added to its head, after its title, meta tags, stylesheets and
+// scripts, like an inline "style" tag.
+func (doc Document) Head(content ...html.Content) Document {
+	doc.head = append(slices.Clip(doc.head), content...)
+	return doc
+}
+
+// Body returns a copy of the document with content added to its body.  Like tag.Interface.Add, html.Attr content is
+// added to the "body" start tag.
+func (doc Document) Body(content ...html.Content) Document {
+	doc.body = append(slices.Clip(doc.body), content...)
+	return doc
+}
+
+// AppendHTML implements html.Content by appending the document.
+func (doc Document) AppendHTML(buf []byte) []byte {
+	return doc.AppendHTMLContext(context.Background(), buf)
+}
+
+// AppendHTMLContext implements html.ContextContent by appending the document, passing ctx to its content.  The body
+// is rendered before the head, so the assets that the body requires with html.Require can be added to the head.
+func (doc Document) AppendHTMLContext(ctx context.Context, buf []byte) []byte {
+	if html.SearchingFragment(ctx) {
+		return html.AppendContext(ctx, buf, doc.Children()...)
+	}
+	ctx, assets := doc.collect(ctx)
+	body := html.GetBuffer(html.DefaultChunkSize)
+	defer html.PutBuffer(body)
+	*body = html.AppendContext(ctx, *body, doc.bodyElement())
+	return html.AppendContext(ctx, buf, doctype, doc.element(assets.Close(), html.HTML(*body)))
+}
+
+// StreamHTML implements html.Streamer by writing the document in pieces, which lets a large body be streamed.  Since
+// the head is written before the body is rendered, assets that the body requires with html.Require that were not
+// added to the document with Stylesheet or Script are written in the body where they are first required.
+func (doc Document) StreamHTML(w *html.Writer) error {
+	if html.SearchingFragment(w.Context()) {
+		return w.Render(doc.Children()...)
+	}
+	ctx, assets := doc.collect(w.Context())
+	return w.Render(doctype, doc.element(assets.Close(), streamBody{ctx, doc.bodyElement()}))
+}
+
+// collect returns a context that collects the assets required by the content of the document, starting with its
+// stylesheets and scripts.
+func (doc Document) collect(ctx context.Context) (context.Context, *html.Assets) {
+	ctx, assets := html.CollectAssets(ctx)
+	for _, s := range doc.stylesheets {
+		assets.Add(s)
+	}
+	for _, s := range doc.scripts {
+		assets.Add(s)
+	}
+	return ctx, assets
+}
+
+// streamBody streams the body of a document with a context that collects its assets.
+type streamBody struct {
+	ctx  context.Context
+	body html.Content
+}
+
+func (sb streamBody) AppendHTML(buf []byte) []byte { return html.AppendContext(sb.ctx, buf, sb.body) }
+
+func (sb streamBody) StreamHTML(w *html.Writer) error {
+	_, err := html.WriteToContext(sb.ctx, w, sb.body)
+	return err
+}
+
+// TagName implements html.Node like html.Group, since a document is a doctype followed by an "html" element.
+func (doc Document) TagName() string { return `` }
+
+// Attributes implements html.Node with no attributes.
+func (doc Document) Attributes() iter.Seq2[string, string] { return html.Group{}.Attributes() }
+
+// Children implements html.Node by returning the doctype and the "html" element, so a document can be walked with
+// html.Walk and searched with the query package.  Assets required by the body are not added to the head.
+func (doc Document) Children() []html.Content {
+	assets := make([]html.Asset, 0, len(doc.stylesheets)+len(doc.scripts))
+	for _, s := range doc.stylesheets {
+		assets = append(assets, s)
+	}
+	for _, s := range doc.scripts {
+		assets = append(assets, s)
+	}
+	return []html.Content{doctype, doc.element(assets, doc.bodyElement())}
+}
+
+// WithChildren implements html.Node like html.Group, returning a group with the provided content.
+func (doc Document) WithChildren(children ...html.Content) html.Node { return html.Group(children) }
+
+var doctype = html.HTML(`<!DOCTYPE html>`)
+
+// element returns the "html" element of the document, with the assets in its head.
+func (doc Document) element(assets []html.Asset, body html.Content) tag.Interface {
+	lang := doc.lang
+	if lang == `` {
+		lang = `en`
+	}
+	head := make([]html.Content, 0, 2+len(doc.meta)+len(assets)+len(doc.head))
+	head = append(head, charset)
+	if doc.title != `` {
+		head = append(head, title.Text(doc.title))
+	}
+	for _, m := range doc.meta {
+		head = append(head, metaTag.Set(`name`, m.name).Set(`content`, m.content))
+	}
+	for _, asset := range assets {
+		head = append(head, asset)
+	}
+	head = append(head, doc.head...)
+	return tag.New(`html`).Set(`lang`, lang).Add(tag.New(`head`).Add(head...), body)
+}
+
+func (doc Document) bodyElement() tag.Interface { return tag.New(`body`).Add(doc.body...) }
+
+// withIntegrity adds a Subresource Integrity hash to a tag, with the crossorigin attribute that browsers need to
+// check it for resources from a CDN.
+func withIntegrity(t tag.Interface, integrity string) tag.Interface {
+	if integrity == `` {
+		return t
+	}
+	return t.Set(`integrity`, integrity).Set(`crossorigin`, `anonymous`)
+}
+
+var (
+	charset    = tag.New(`meta[charset=utf-8]`)
+	title      = tag.New(`title`)
+	metaTag    = tag.New(`meta`)
+	stylesheet = tag.New(`link[rel=stylesheet]`)
+	script     = tag.New(`script`)
+)
@@ -0,0 +1,127 @@
+package page_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/page"
+	"github.com/swdunlop/html-go/query"
+	"github.com/swdunlop/html-go/tag"
+)
+
+var _ html.Node = page.Document{}
+
+func TestDocument(t *testing.T) {
+	test := func(expect string, content html.Content) {
+		t.Helper()
+		result := string(html.Append(nil, content))
+		if result != expect {
+			t.Errorf("expected %q, got %q", expect, result)
+		}
+	}
+	test(`<!DOCTYPE html><html lang='en'><head><meta charset='utf-8'></head><body></body></html>`, page.Document{})
+
+	base := page.New(`Base`).Stylesheet(page.Stylesheet{Href: `/app.css`}).Script(
+		page.Script{Src: `https://unpkg.com/htmx.org@1.9.2`, Integrity: `sha384-abc`, Defer: true},
+	)
+	doc := base.Title(`Users`).Lang(`fr`).Meta(`description`, `All users`).Meta(`viewport`, `width=500`).Stylesheet(
+		page.Stylesheet{Href: `/print.css`, Media: `print`},
+		page.Stylesheet{Href: `/app.css`, Media: `screen`},
+	).Script(
+		page.Script{Src: `https://unpkg.com/htmx.org@1.9.2`},
+		page.Script{Src: `/app.js`, Module: true},
+	).Head(
+		tag.New(`style`).Text(`body{margin:0}`),
+	).Body(
+		html.Attribute(`class`, `dark`),
+		tag.New(`h1`).Text(`Users`),
+	)
+	test(`<!DOCTYPE html><html lang='fr'><head><meta charset='utf-8'><title>Users</title>`+
+		`<meta name='viewport' content='width=500'><meta name='description' content='All users'>`+
+		`<link rel='stylesheet' href='/app.css'><link rel='stylesheet' href='/print.css' media='print'>`+
+		`<script src='https://unpkg.com/htmx.org@1.9.2' defer integrity='sha384-abc' crossorigin='anonymous'></script>`+
+		`<script src='/app.js' type='module'></script><style>body{margin:0}</style></head>`+
+		`<body class='dark'><h1>Users</h1></body></html>`, doc)
+
+	// the base document is not changed by the copies made from it.
+	test(`<!DOCTYPE html><html lang='en'><head><meta charset='utf-8'><title>Base</title>`+
+		`<meta name='viewport' content='width=device-width, initial-scale=1'><link rel='stylesheet' href='/app.css'>`+
+		`<script src='https://unpkg.com/htmx.org@1.9.2' defer integrity='sha384-abc' crossorigin='anonymous'></script>`+
+		`</head><body></body></html>`, base)
+}
+
+func TestDocumentFragment(t *testing.T) {
+	doc := page.New(`Users`).Body(tag.New(`h1#title`).Text(`Users`))
+	result := string(html.Append(nil, html.Fragment(doc, `title`)))
+	if expect := `<h1 id='title'>Users</h1>`; result != expect {
+		t.Errorf("expected %q, got %q", expect, result)
+	}
+}
+
+func TestDocumentStream(t *testing.T) {
+	doc := page.New(`Users`).Body(tag.New(`h1`).Text(`Users`))
+	var buf strings.Builder
+	w := html.NewWriter(&buf, 0)
+	if err := w.Render(doc); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if expect := string(html.AppendContext(context.Background(), nil, doc)); buf.String() != expect {
+		t.Errorf("expected %q, got %q", expect, buf.String())
+	}
+}
+
+func TestDocumentQuery(t *testing.T) {
+	doc := page.New(`Users`).Body(tag.New(`h1`).Text(`Users`))
+	if found := query.All(doc, `html > body > h1`); len(found) != 1 {
+		t.Errorf("expected to find the heading in the body, found %v", found)
+	}
+}
+
+func TestDocumentAssets(t *testing.T) {
+	widget := tag.New(`div.widget`).Add(html.Require(
+		html.InlineStyle(`.widget{color:red}`),
+		html.InlineScript(`x</script><img onerror=1>`),
+		page.Script{Src: `/widget.js`, Defer: true},
+		page.Script{Src: `/app.js`},
+	))
+	doc := page.Document{}.Script(page.Script{Src: `/app.js`}).Body(widget, widget)
+
+	result := string(html.Append(nil, doc))
+	expect := `<!DOCTYPE html><html lang='en'><head><meta charset='utf-8'><script src='/app.js'></script>` +
+		`<style>.widget{color:red}</style><script>x<\/script><img onerror=1></script>` +
+		`<script src='/widget.js' defer></script></head>` +
+		`<body><div class='widget'></div><div class='widget'></div></body></html>`
+	if result != expect {
+		t.Errorf("expected %q, got %q", expect, result)
+	}
+
+	// streaming writes the head first, so the assets are written where they are first required.
+	var buf strings.Builder
+	w := html.NewWriter(&buf, 0)
+	if err := w.Render(doc); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	expect = `<!DOCTYPE html><html lang='en'><head><meta charset='utf-8'><script src='/app.js'></script></head>` +
+		`<body><div class='widget'><style>.widget{color:red}</style><script>x<\/script><img onerror=1></script>` +
+		`<script src='/widget.js' defer></script></div>` +
+		`<div class='widget'></div></body></html>`
+	if buf.String() != expect {
+		t.Errorf("expected %q when streamed, got %q", expect, buf.String())
+	}
+}
+
+func TestDocumentUnsafeURL(t *testing.T) {
+	doc := page.Document{}.Stylesheet(page.Stylesheet{Href: html.SafeURL(`javascript:alert(1)`)})
+	result := string(html.Append(nil, doc))
+	if expect := `<link rel='stylesheet' href='#ZgotmplZ'>`; !strings.Contains(result, expect) {
+		t.Errorf("expected %q in %q", expect, result)
+	}
+}
@@ -0,0 +1,52 @@
+package html
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// GetBuffer returns an empty buffer with room for at least size bytes from a shared pool, which lets code that renders
+// content into a temporary buffer, like a response or an event, avoid allocating a new buffer each time.  The buffer
+// should be returned with PutBuffer once its content has been written or copied.
+//
+// Buffers are pooled in size classes from 1KiB to 1MiB, each twice the size of the last, so a request for a small
+// buffer does not hold on to a large one.  Larger buffers are allocated as needed.
+func GetBuffer(size int) *[]byte {
+	class := bufferClass(size)
+	if class >= len(bufferPools) {
+		buf := make([]byte, 0, size)
+		return &buf
+	}
+	if buf, ok := bufferPools[class].Get().(*[]byte); ok {
+		*buf = (*buf)[:0]
+		return buf
+	}
+	buf := make([]byte, 0, minBufferSize<<class)
+	return &buf
+}
+
+// PutBuffer returns a buffer from GetBuffer to the pool, sorting it by its capacity since appending may have grown it.
+// Buffers smaller than 1KiB or larger than 1MiB are left for the garbage collector.  The buffer must not be used after
+// it is returned.
+func PutBuffer(buf *[]byte) {
+	c := cap(*buf)
+	if c < minBufferSize {
+		return
+	}
+	class := bits.Len(uint(c/minBufferSize)) - 1 // the largest class that the buffer can satisfy.
+	if class < len(bufferPools) {
+		bufferPools[class].Put(buf)
+	}
+}
+
+// bufferClass returns the smallest size class that holds size bytes.
+func bufferClass(size int) int {
+	if size <= minBufferSize {
+		return 0
+	}
+	return bits.Len(uint(size-1) / minBufferSize)
+}
+
+const minBufferSize = 1024
+
+var bufferPools [11]sync.Pool // 1KiB to 1MiB
@@ -0,0 +1,95 @@
+package html_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+)
+
+func TestBuffer(t *testing.T) {
+	for _, test := range []struct{ size, capacity int }{
+		{0, 1024}, {1024, 1024}, {1025, 2048}, {5000, 8192}, {1 << 20, 1 << 20}, {1<<20 + 1, 1<<20 + 1},
+	} {
+		buf := html.GetBuffer(test.size)
+		if len(*buf) != 0 || cap(*buf) != test.capacity {
+			t.Errorf("GetBuffer(%v): expected an empty buffer with capacity %v, got %v/%v",
+				test.size, test.capacity, len(*buf), cap(*buf))
+		}
+		*buf = append(*buf, `garbage`...)
+		html.PutBuffer(buf)
+	}
+	for range 10 { // the pool may drop buffers, but not hand out one that is too small or not empty.
+		buf := html.GetBuffer(3000)
+		if len(*buf) != 0 || cap(*buf) < 3000 {
+			t.Errorf("GetBuffer(3000): got %v/%v", len(*buf), cap(*buf))
+		}
+		*buf = append(*buf, make([]byte, 5000)...) // grown buffers are sorted by their new capacity.
+		html.PutBuffer(buf)
+	}
+}
+
+// benchmarkPage is a page large enough to need a few chunks, like a modest table.
+var benchmarkPage = tag.New(`table`).Add(html.Map(make([]int, 500), func(i int) html.Content {
+	return tag.New(`tr`).Add(tag.New(`td`).Text(strconv.Itoa(i)), tag.New(`td`).Text(`Some text for the row`))
+}))
+
+// BenchmarkBuffer compares rendering into a new buffer, like html.Append(nil, ...), with a pooled buffer, with every
+// processor rendering pages at once like a busy server.
+func BenchmarkBuffer(b *testing.B) {
+	b.Run(`make`, func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				buf := html.Append(make([]byte, 0, html.DefaultChunkSize), benchmarkPage)
+				_, _ = io.Discard.Write(buf)
+			}
+		})
+	})
+	b.Run(`pool`, func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				buf := html.GetBuffer(html.DefaultChunkSize)
+				*buf = html.Append(*buf, benchmarkPage)
+				_, _ = io.Discard.Write(*buf)
+				html.PutBuffer(buf)
+			}
+		})
+	})
+}
+
+func BenchmarkRender(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		r := httptest.NewRequest(`GET`, `/`, nil)
+		r.Header.Set(`Accept-Encoding`, `gzip`)
+		for pb.Next() {
+			w := httptest.NewRecorder()
+			if err := html.Render(w, r, 200, benchmarkPage); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkWriteTo(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := html.WriteTo(io.Discard, benchmarkPage); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkStatic(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		html.Static(tag.New(`p`).Text(`A paragraph of static text.`))
+	}
+}
@@ -0,0 +1,253 @@
+// Package pretty renders HTML content with line breaks and indentation, which makes rendered pages much easier to read
+// and diff in tests and bug reports.  It is meant for debugging: the output adds and removes whitespace between
+// elements where browsers ignore it, but is otherwise the same as the output of html.Append.
+//
+// Block elements, like "div" and "ul", start on a new line and have their content indented, unless they only contain
+// text and inline elements, like "a" and "em", in which case they are kept on one line.  The content of "pre",
+// "textarea", "script" and "style" is never changed, since whitespace matters there.
+package pretty
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/internal/capture"
+	"github.com/swdunlop/html-go/internal/scan"
+	"github.com/swdunlop/html-go/internal/whatwg"
+)
+
+// Indent is the indentation added for each level of nesting.
+const Indent = `  `
+
+// Append appends the content to buf with line breaks and indentation.
+func Append(buf []byte, content ...html.Content) []byte {
+	return AppendContext(context.Background(), buf, content...)
+}
+
+// AppendContext is like Append, but renders the content with a context, like html.AppendContext.
+func AppendContext(ctx context.Context, buf []byte, content ...html.Content) []byte {
+	return Format(buf, html.AppendContext(ctx, nil, content...))
+}
+
+// String returns the content with line breaks and indentation, which is convenient in tests.
+func String(content ...html.Content) string { return string(Append(nil, content...)) }
+
+// Format appends HTML that has already been rendered to buf, with line breaks and indentation.
+func Format(buf []byte, src []byte) []byte {
+	root := parse(string(src))
+	p := printer{buf: buf}
+	p.children(root.children, 0)
+	return p.buf
+}
+
+// Middleware formats HTML responses for requests with a "pretty" query parameter of "1" or "true", like
+// "/users/?pretty=1", and passes other requests through untouched.  Formatting requires buffering the whole response,
+// so this should only be used during development.  Formatted responses are not compressed and have no ETag.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get(`pretty`) {
+		case `1`, `true`:
+		default:
+			next.ServeHTTP(w, r)
+			return
+		}
+		// the response must be uncompressed and complete to be formatted, so the handler is not told that the client
+		// accepts compression or already has a cached copy.
+		r = r.Clone(r.Context())
+		r.Header.Del(`Accept-Encoding`)
+		r.Header.Del(`If-None-Match`)
+		rsp := capture.New(w)
+		next.ServeHTTP(rsp, r)
+		body := rsp.Body.Bytes()
+		if rsp.HTML() {
+			body = Format(make([]byte, 0, len(body)+len(body)/2), body)
+			rsp.Header().Del(`ETag`) // the ETag is for the unformatted HTML.
+		}
+		_ = rsp.Send(w, body)
+	})
+}
+
+// node is an element, text, comment or doctype in the rendered HTML, which keeps its source so it can be printed
+// without changes.
+type node struct {
+	tok      scan.Token
+	children []*node
+	end      string // the source of the end tag, which is empty if it was omitted.
+}
+
+// parse builds a tree of nodes from rendered HTML.  Unlike tag.Parse, it does not imply end tags, since HTML
+// rendered by this module has them, but it still tolerates end tags that do not match.
+func parse(src string) *node {
+	root := &node{}
+	stack := []*node{root}
+	s := scan.New(src)
+	for tok, ok := s.Next(); ok; tok, ok = s.Next() {
+		n := &node{tok: tok}
+		top := stack[len(stack)-1]
+		switch tok.Kind {
+		case scan.StartTag:
+			top.children = append(top.children, n)
+			if elem, ok := whatwg.Lookup(tok.Name); !tok.SelfClosing && !(ok && elem.Void) {
+				stack = append(stack, n)
+			}
+		case scan.EndTag:
+			i := len(stack) - 1
+			for i > 0 && !strings.EqualFold(stack[i].tok.Name, tok.Name) {
+				i--
+			}
+			if i == 0 {
+				top.children = append(top.children, n) // a stray end tag is kept as it was.
+				continue
+			}
+			stack[i].end = tok.Raw
+			stack = stack[:i]
+		default:
+			top.children = append(top.children, n)
+		}
+	}
+	return root
+}
+
+// block returns true if the node should start on a new line.
+func (n *node) block() bool {
+	switch n.tok.Kind {
+	case scan.Text, scan.EndTag:
+		return false
+	case scan.StartTag:
+		elem, ok := whatwg.Lookup(n.tok.Name)
+		if !ok || !elem.Inline {
+			return true
+		}
+		for _, child := range n.children {
+			if child.block() {
+				return true
+			}
+		}
+		return false
+	}
+	return true // comments and doctypes
+}
+
+// verbatim returns true if whitespace in the content of the node matters.
+func (n *node) verbatim() bool {
+	switch strings.ToLower(n.tok.Name) {
+	case `pre`, `textarea`, `script`, `style`, `listing`, `xmp`, `plaintext`:
+		return n.tok.Kind == scan.StartTag
+	}
+	return false
+}
+
+type printer struct {
+	buf []byte
+}
+
+// children prints a list of nodes, putting each block on its own line and runs of inline content on one line.
+func (p *printer) children(nodes []*node, depth int) {
+	start := 0
+	for i, n := range nodes {
+		if !n.block() {
+			continue
+		}
+		p.line(nodes[start:i], depth)
+		p.block(n, depth)
+		start = i + 1
+	}
+	p.line(nodes[start:], depth)
+}
+
+// line prints inline content on one line, collapsing whitespace, unless it is only whitespace.
+func (p *printer) line(nodes []*node, depth int) {
+	var buf []byte
+	for _, n := range nodes {
+		buf = appendInline(buf, n)
+	}
+	text := strings.TrimSpace(string(buf))
+	if text == `` {
+		return
+	}
+	p.indent(depth)
+	p.buf = append(p.buf, text...)
+	p.buf = append(p.buf, '\n')
+}
+
+// block prints a block on its own line, with its content on the same line if it has no blocks in it.
+func (p *printer) block(n *node, depth int) {
+	p.indent(depth)
+	switch {
+	case n.tok.Kind != scan.StartTag, n.verbatim():
+		p.buf = appendRaw(p.buf, n)
+	case !hasBlocks(n.children):
+		var buf []byte
+		for _, child := range n.children {
+			buf = appendInline(buf, child)
+		}
+		p.buf = append(p.buf, n.tok.Raw...)
+		p.buf = append(p.buf, strings.TrimSpace(string(buf))...)
+		p.buf = append(p.buf, n.end...)
+	default:
+		p.buf = append(p.buf, n.tok.Raw...)
+		p.buf = append(p.buf, '\n')
+		p.children(n.children, depth+1)
+		p.indent(depth)
+		p.buf = append(p.buf, n.end...)
+	}
+	p.buf = append(p.buf, '\n')
+}
+
+func (p *printer) indent(depth int) {
+	for range depth {
+		p.buf = append(p.buf, Indent...)
+	}
+}
+
+func hasBlocks(nodes []*node) bool {
+	for _, n := range nodes {
+		if n.block() {
+			return true
+		}
+	}
+	return false
+}
+
+// appendInline appends a node with whitespace in text collapsed to a single space, unless it is verbatim.
+func appendInline(buf []byte, n *node) []byte {
+	switch {
+	case n.tok.Kind == scan.Text:
+		return appendCollapsed(buf, n.tok.Raw)
+	case n.tok.Kind != scan.StartTag, n.verbatim():
+		return appendRaw(buf, n)
+	}
+	buf = append(buf, n.tok.Raw...)
+	for _, child := range n.children {
+		buf = appendInline(buf, child)
+	}
+	return append(buf, n.end...)
+}
+
+// appendRaw appends the source of a node and its content.
+func appendRaw(buf []byte, n *node) []byte {
+	buf = append(buf, n.tok.Raw...)
+	for _, child := range n.children {
+		buf = appendRaw(buf, child)
+	}
+	return append(buf, n.end...)
+}
+
+func appendCollapsed(buf []byte, text string) []byte {
+	space := len(buf) > 0 && buf[len(buf)-1] == ' '
+	for i := 0; i < len(text); i++ {
+		switch ch := text[i]; ch {
+		case ' ', '\t', '\n', '\r', '\f':
+			if !space {
+				buf = append(buf, ' ')
+			}
+			space = true
+		default:
+			buf = append(buf, ch)
+			space = false
+		}
+	}
+	return buf
+}
@@ -0,0 +1,77 @@
+package pretty_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/pretty"
+	"github.com/swdunlop/html-go/tag"
+)
+
+func TestString(t *testing.T) {
+	test := func(name, expect string, content ...html.Content) {
+		t.Helper()
+		result := pretty.String(content...)
+		if result != expect {
+			t.Errorf("%v: expected:\n%v\ngot:\n%v", name, expect, result)
+		}
+	}
+	test(`inline`, "<p>Hello, <b>World</b>!</p>\n",
+		tag.New(`p`).Text(`Hello, `).Add(tag.New(`b`).Text(`World`)).Text(`!`))
+	test(`nested`, "<main>\n  <h1>Title</h1>\n  <ul>\n    <li>One</li>\n    <li>Two</li>\n  </ul>\n</main>\n",
+		tag.New(`main`).Add(
+			tag.New(`h1`).Text(`Title`),
+			tag.New(`ul`).Add(tag.New(`li`).Text(`One`), tag.New(`li`).Text(`Two`)),
+		))
+	test(`mixed`, "<div>\n  Some <em>text</em>\n  <p>More</p>\n  <br>\n</div>\n",
+		tag.New(`div`).Text("Some\n   ").Add(tag.New(`em`).Text(`text`), tag.New(`p`).Text(`More`), tag.New(`br`)))
+	test(`pre`, "<div>\n  <pre>  keep\n    this</pre>\n</div>\n",
+		tag.New(`div`).Add(tag.New(`pre`).Text("  keep\n    this")))
+	test(`textarea`, "<form><label>Note <textarea>  a\n b</textarea></label></form>\n",
+		tag.New(`form`).Add(tag.New(`label`).Text(`Note `).Add(tag.New(`textarea`).Text("  a\n b"))))
+	test(`document`, "<!DOCTYPE html>\n<html>\n  <head>\n    <title>T</title>\n  </head>\n</html>\n",
+		html.HTML(`<!DOCTYPE html>`), tag.New(`html`).Add(tag.New(`head`).Add(tag.New(`title`).Text(`T`))))
+}
+
+func TestMiddleware(t *testing.T) {
+	handler := pretty.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(`Content-Type`, `text/html; charset=utf-8`)
+		w.WriteHeader(http.StatusTeapot)
+		_, _ = w.Write(html.Append(nil, tag.New(`div`).Add(tag.New(`p`).Text(`Hi`))))
+	}))
+	test := func(url, expect string) {
+		t.Helper()
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(`GET`, url, nil))
+		if w.Code != http.StatusTeapot {
+			t.Errorf("%v: expected status %v, got %v", url, http.StatusTeapot, w.Code)
+		}
+		if result := w.Body.String(); result != expect {
+			t.Errorf("%v: expected %q, got %q", url, expect, result)
+		}
+	}
+	test(`/`, `<div><p>Hi</p></div>`)
+	test(`/?pretty=1`, "<div>\n  <p>Hi</p>\n</div>\n")
+}
+
+func TestMiddlewareRender(t *testing.T) {
+	handler := pretty.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = html.Render(w, r, http.StatusOK, tag.New(`div`).Add(tag.New(`p`).Text(strings.Repeat(`Hi `, 1000))))
+	}))
+	r := httptest.NewRequest(`GET`, `/?pretty=1`, nil)
+	r.Header.Set(`Accept-Encoding`, `gzip`)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if encoding := w.Header().Get(`Content-Encoding`); encoding != `` {
+		t.Errorf("expected an uncompressed response, got %q", encoding)
+	}
+	if etag := w.Header().Get(`ETag`); etag != `` {
+		t.Errorf("expected no ETag for the formatted response, got %q", etag)
+	}
+	if !strings.HasPrefix(w.Body.String(), "<div>\n  <p>Hi ") {
+		t.Errorf("expected a formatted response, got %q", w.Body.String())
+	}
+}
@@ -0,0 +1,352 @@
+// Package query finds the tags in content that match a CSS selector, like `form > input[type=hidden]`, without
+// rendering the content, which is useful in tests, in middleware that changes part of a page, and when extracting the
+// part of a page that an htmx request targets.
+//
+// Selectors extend the compound selectors used by tag.New, like `input#name.wide[required]`, with:
+//
+//   - selector lists, like `h1, h2`, and the universal selector, "*";
+//   - the descendant, child, next sibling and subsequent sibling combinators, like `ul li`, `ul > li`, `h1 + p` and
+//     `h1 ~ p`;
+//   - the attribute operators "~=", "|=", "^=", "$=" and "*=", and the "i" flag, like `[type=TEXT i]`;
+//   - the pseudo-classes ":first-child", ":last-child", ":only-child", ":nth-child(An+B)", ":nth-last-child(An+B)",
+//     the "-of-type" variants of each, ":not(list)", ":is(list)", ":where(list)", ":empty", ":root", ":checked",
+//     ":disabled" and ":enabled".
+//
+// Content is searched like html.Walk: groups are searched, a Func or ContextFunc is called, with context.Background()
+// for a ContextFunc, and other content, like html.HTML, is skipped.  Elements at the top of the content match ":root"
+// and are siblings of each other.
+package query
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/internal/selector"
+	"github.com/swdunlop/html-go/tag"
+)
+
+// A Selector is a compiled selector, which can be reused to search content.
+type Selector struct {
+	src  string
+	list selector.List
+}
+
+// Compile parses a selector, returning an error describing the problem if it is not valid.
+func Compile(src string) (*Selector, error) {
+	list, err := selector.ParseList(src)
+	if err != nil {
+		return nil, err
+	}
+	return &Selector{src: src, list: list}, nil
+}
+
+// MustCompile is like Compile, but panics if the selector is not valid, which makes it convenient for package
+// variables.
+func MustCompile(src string) *Selector {
+	sel, err := Compile(src)
+	if err != nil {
+		panic(err)
+	}
+	return sel
+}
+
+// String returns the source of the selector.
+func (sel *Selector) String() string { return sel.src }
+
+// All returns the tags in the content that match the selector, in the order they would be rendered.
+func All(content html.Content, selector string) []tag.Interface {
+	return MustCompile(selector).All(content)
+}
+
+// First returns the first tag in the content that matches the selector, or false if no tag matches.
+func First(content html.Content, selector string) (tag.Interface, bool) {
+	return MustCompile(selector).First(content)
+}
+
+// All returns the tags in the content that match the selector, in the order they would be rendered.
+func (sel *Selector) All(content html.Content) []tag.Interface {
+	var found []tag.Interface
+	build(content).each(func(e *element) bool {
+		if e.matchesList(sel.list) {
+			found = append(found, e.tag)
+		}
+		return true
+	})
+	return found
+}
+
+// First returns the first tag in the content that matches the selector, or false if no tag matches.
+func (sel *Selector) First(content html.Content) (tag.Interface, bool) {
+	var found tag.Interface
+	build(content).each(func(e *element) bool {
+		if e.matchesList(sel.list) {
+			found = e.tag
+			return false
+		}
+		return true
+	})
+	return found, found != nil
+}
+
+// Match returns true if the tag matches the selector on its own, as the root of its content.
+func (sel *Selector) Match(t tag.Interface) bool {
+	root := build(t)
+	return len(root.children) == 1 && root.children[0].matchesList(sel.list)
+}
+
+// element is a tag with links to its parent and siblings, which selectors need.
+type element struct {
+	tag      tag.Interface
+	parent   *element // the root element for elements at the top of the content
+	children []*element
+	index    int  // the index of the element among the children of its parent
+	empty    bool // true if the element has no content other than comments
+	attrs    map[string]string
+}
+
+// build returns a root element, which is not a tag, with the tags in the content as its children.
+func build(content html.Content) *element {
+	root := &element{empty: true}
+	root.add(content)
+	return root
+}
+
+func (e *element) add(content html.Content) {
+	switch content := content.(type) {
+	case html.Func:
+		e.add(content())
+	case html.ContextFunc:
+		e.add(content(context.Background()))
+	case tag.Interface:
+		child := &element{tag: content, parent: e, index: len(e.children), empty: true}
+		e.children = append(e.children, child)
+		e.empty = false
+		for _, item := range content.Children() {
+			child.add(item)
+		}
+	case html.Text:
+		if content != `` {
+			e.empty = false
+		}
+	case html.Group:
+		for _, item := range content {
+			e.add(item)
+		}
+	case html.HTML:
+		if !strings.HasPrefix(string(content), `<!--`) {
+			e.empty = false
+		}
+	case html.Node:
+		if content.TagName() != `` { // an element that is not a tag cannot be found, but its descendants can.
+			e.empty = false
+		}
+		for _, item := range content.Children() { // like a group, such as a page.Document.
+			e.add(item)
+		}
+	default:
+		e.empty = false
+	}
+}
+
+// each calls fn for each descendant of the element, in the order they would be rendered, until fn returns false.
+func (e *element) each(fn func(*element) bool) bool {
+	for _, child := range e.children {
+		if !fn(child) || !child.each(fn) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *element) attr(name string) (string, bool) {
+	if e.attrs == nil {
+		e.attrs = make(map[string]string)
+		for name, value := range e.tag.Attributes() {
+			e.attrs[name] = value
+		}
+	}
+	value, ok := e.attrs[name]
+	return value, ok
+}
+
+func (e *element) matchesList(list selector.List) bool {
+	for _, complex := range list {
+		if e.matchesComplex(complex) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesComplex matches the last step of a complex selector with the element, then the steps before it with the
+// related elements, trying each possibility for the descendant and subsequent sibling combinators.
+func (e *element) matchesComplex(steps selector.Complex) bool {
+	last := len(steps) - 1
+	if !e.matchesCompound(steps[last].Compound) {
+		return false
+	}
+	if last == 0 {
+		return true
+	}
+	rest := steps[:last]
+	switch steps[last].Combinator {
+	case selector.Child:
+		return e.parent.tag != nil && e.parent.matchesComplex(rest)
+	case selector.NextSibling:
+		return e.index > 0 && e.parent.children[e.index-1].matchesComplex(rest)
+	case selector.SubsequentSibling:
+		for _, sibling := range e.parent.children[:e.index] {
+			if sibling.matchesComplex(rest) {
+				return true
+			}
+		}
+	default:
+		for ancestor := e.parent; ancestor.tag != nil; ancestor = ancestor.parent {
+			if ancestor.matchesComplex(rest) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (e *element) matchesCompound(compound selector.Compound) bool {
+	if compound.Name != `` && !strings.EqualFold(compound.Name, e.tag.TagName()) {
+		return false
+	}
+	if compound.ID != `` && compound.ID != e.tag.ID() {
+		return false
+	}
+	if len(compound.Classes) > 0 {
+		value, _ := e.attr(`class`)
+		classes := strings.Fields(value)
+		for _, class := range compound.Classes {
+			if !slices.Contains(classes, class) {
+				return false
+			}
+		}
+	}
+	for _, attr := range compound.Attributes {
+		value, ok := e.attr(attr.Name)
+		if !ok || attr.HasValue && !matchesValue(attr, value) {
+			return false
+		}
+	}
+	for _, pseudo := range compound.Pseudo {
+		if !e.matchesPseudo(pseudo) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesValue(attr selector.Attribute, value string) bool {
+	want := attr.Value
+	if attr.IgnoreCase {
+		want, value = strings.ToLower(want), strings.ToLower(value)
+	}
+	switch attr.Match {
+	case '~':
+		return slices.Contains(strings.Fields(value), want)
+	case '|':
+		return value == want || strings.HasPrefix(value, want+`-`)
+	case '^':
+		return want != `` && strings.HasPrefix(value, want)
+	case '$':
+		return want != `` && strings.HasSuffix(value, want)
+	case '*':
+		return want != `` && strings.Contains(value, want)
+	}
+	return value == want
+}
+
+func (e *element) matchesPseudo(pseudo selector.Pseudo) bool {
+	switch pseudo.Name {
+	case `first-child`:
+		return e.index == 0
+	case `last-child`:
+		return e.index == len(e.parent.children)-1
+	case `only-child`:
+		return len(e.parent.children) == 1
+	case `nth-child`:
+		return nth(pseudo.A, pseudo.B, e.index+1)
+	case `nth-last-child`:
+		return nth(pseudo.A, pseudo.B, len(e.parent.children)-e.index)
+	case `first-of-type`:
+		return e.position(false) == 1
+	case `last-of-type`:
+		return e.position(true) == 1
+	case `only-of-type`:
+		return e.position(false) == 1 && e.position(true) == 1
+	case `nth-of-type`:
+		return nth(pseudo.A, pseudo.B, e.position(false))
+	case `nth-last-of-type`:
+		return nth(pseudo.A, pseudo.B, e.position(true))
+	case `not`:
+		return !e.matchesList(pseudo.Selectors)
+	case `is`, `where`:
+		return e.matchesList(pseudo.Selectors)
+	case `empty`:
+		return e.empty
+	case `root`:
+		return e.parent.tag == nil
+	case `checked`:
+		_, checked := e.attr(`checked`)
+		_, selected := e.attr(`selected`)
+		return checked || selected && strings.EqualFold(e.tag.TagName(), `option`)
+	case `disabled`, `enabled`:
+		return e.disabled() == (pseudo.Name == `disabled`) && e.disableable()
+	}
+	return false
+}
+
+// position returns the position of the element among siblings with the same name, starting at 1, from the last
+// sibling if last is true.
+func (e *element) position(last bool) int {
+	siblings := e.parent.children
+	n := 1
+	if last {
+		for _, sibling := range siblings[e.index+1:] {
+			if strings.EqualFold(sibling.tag.TagName(), e.tag.TagName()) {
+				n++
+			}
+		}
+		return n
+	}
+	for _, sibling := range siblings[:e.index] {
+		if strings.EqualFold(sibling.tag.TagName(), e.tag.TagName()) {
+			n++
+		}
+	}
+	return n
+}
+
+// nth returns true if the position is A*n+B for some n >= 0.
+func nth(a, b, position int) bool {
+	if a == 0 {
+		return position == b
+	}
+	n := position - b
+	return n%a == 0 && n/a >= 0
+}
+
+// disableable returns true if the element is a form control that can be disabled.
+func (e *element) disableable() bool {
+	switch strings.ToLower(e.tag.TagName()) {
+	case `button`, `input`, `select`, `textarea`, `optgroup`, `option`, `fieldset`:
+		return true
+	}
+	return false
+}
+
+// disabled returns true if the element, or a fieldset that contains it, has the "disabled" attribute.
+func (e *element) disabled() bool {
+	for ancestor := e; ancestor.tag != nil; ancestor = ancestor.parent {
+		if _, ok := ancestor.attr(`disabled`); ok && (ancestor == e || ancestor.tag.TagName() == `fieldset`) {
+			return true
+		}
+	}
+	return false
+}
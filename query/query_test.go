@@ -0,0 +1,150 @@
+package query_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/el"
+	"github.com/swdunlop/html-go/query"
+	"github.com/swdunlop/html-go/tag"
+)
+
+var page = html.Group{
+	tag.New(`h1#title`).Text(`Title`),
+	tag.New(`p.lead`).Text(`Lead`),
+	tag.New(`form#login[method=post]`).Add(
+		tag.New(`input[type=hidden][name=csrf]`),
+		tag.New(`fieldset[disabled]`).Add(
+			tag.New(`input[type=TEXT][name=user]`),
+		),
+		tag.New(`input[type=checkbox][name=remember][checked]`),
+		tag.New(`button.primary.wide`).Text(`Log In`),
+	),
+	html.Func(func() html.Content {
+		return tag.New(`ul`).Add(
+			tag.New(`li`).Text(`One`),
+			tag.New(`li.even`).Text(`Two`),
+			tag.New(`li`).Text(`Three`),
+			tag.New(`li.even[data-x=a-b]`).Text(`Four`),
+			tag.New(`li`),
+		)
+	}),
+	html.HTML(`<!-- comments are skipped -->`),
+	tag.New(`p[lang=en-US]`).Add(tag.New(`a[href='https://example.com/page.html']`).Text(`Link`)),
+}
+
+func TestAll(t *testing.T) {
+	test := func(sel string, expect ...string) {
+		t.Helper()
+		var result []string
+		for _, found := range query.All(page, sel) {
+			result = append(result, describe(found))
+		}
+		if strings.Join(result, ` `) != strings.Join(expect, ` `) {
+			t.Errorf("%v: expected %q, got %q", sel, expect, result)
+		}
+	}
+	test(`h1`, `h1#title`)
+	test(`#login input`, `input[name=csrf]`, `input[name=user]`, `input[name=remember]`)
+	test(`form > input`, `input[name=csrf]`, `input[name=remember]`)
+	test(`h1 + p`, `p.lead`)
+	test(`h1 ~ p`, `p.lead`, `p`)
+	test(`h1, button`, `h1#title`, `button.primary.wide`)
+	test(`*:root`, `h1#title`, `p.lead`, `form#login`, `ul`, `p`)
+	test(`.primary.wide`, `button.primary.wide`)
+	test(`[type=text]`)
+	test(`[type=text i]`, `input[name=user]`)
+	test(`[lang|=en]`, `p`)
+	test(`[class~=even]`, `li.even`, `li.even`)
+	test(`a[href^=https][href$='.html'][href*=example]`, `a`)
+	test(`li:first-child`, `li`)
+	test(`li:last-child:empty`, `li`)
+	test(`li:nth-child(2n)`, `li.even`, `li.even`)
+	test(`li:nth-child(odd):not(:empty)`, `li`, `li`)
+	test(`li:nth-last-child(-n+2)`, `li.even`, `li`)
+	test(`p:first-of-type`, `p.lead`)
+	test(`p:last-of-type`, `p`)
+	test(`h1:only-of-type`, `h1#title`)
+	test(`fieldset:only-child`)
+	test(`input:is([name=csrf], [name=user])`, `input[name=csrf]`, `input[name=user]`)
+	test(`:checked`, `input[name=remember]`)
+	test(`input:disabled`, `input[name=user]`)
+	test(`input:enabled`, `input[name=csrf]`, `input[name=remember]`)
+	test(`li[data-x]`, `li.even`)
+	test(`section`)
+}
+
+func TestElements(t *testing.T) {
+	form := el.Form(el.Input().Name(`user`), el.Fieldset(el.Input().Name(`pass`))).Set(`id`, `login`)
+	if found := query.All(form, `#login input`); len(found) != 2 {
+		t.Errorf("expected to find both inputs in the el form, found %v", found)
+	}
+	if found := query.All(form, `form > input[name=user]`); len(found) != 1 {
+		t.Errorf("expected to find the user input as a child of the el form, found %v", found)
+	}
+}
+
+func TestFirst(t *testing.T) {
+	found, ok := query.First(page, `li.even`)
+	if !ok || string(html.Append(nil, found)) != `<li class='even'>Two</li>` {
+		t.Errorf("expected the first even li, got %v", found)
+	}
+	if _, ok := query.First(page, `table`); ok {
+		t.Errorf("expected no table")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	sel := query.MustCompile(`li.even:not(:empty)`)
+	if !sel.Match(tag.New(`li.even`).Text(`Two`)) {
+		t.Errorf("expected %v to match", sel)
+	}
+	if sel.Match(tag.New(`li.even`)) {
+		t.Errorf("expected %v not to match an empty li", sel)
+	}
+}
+
+func TestCompile(t *testing.T) {
+	for _, sel := range []string{
+		``, `>`, `a >`, `a > > b`, `a,`, `:nope`, `:nth-child(x)`, `:not(a`, `a)`, `[=x]`, `p:first-child(1)`,
+	} {
+		if _, err := query.Compile(sel); err == nil {
+			t.Errorf("expected %q to be invalid", sel)
+		}
+	}
+	for _, sel := range []string{
+		`*`, `a b > c + d ~ e`, `a,b`, `:nth-child( 2n + 1 )`, `:nth-child(-n+3)`, `:nth-of-type(3)`,
+		`:not(a, b.c)`, `[title="a b"]`, `[type=text i]`, `tag#id.class[attr]:first-child`,
+	} {
+		if _, err := query.Compile(sel); err != nil {
+			t.Errorf("expected %q to be valid, got %v", sel, err)
+		}
+	}
+}
+
+func TestContextFunc(t *testing.T) {
+	content := html.ContextFunc(func(ctx context.Context) html.Content {
+		return tag.New(`div`).Add(tag.New(`span`))
+	})
+	if found := query.All(content, `div > span`); len(found) != 1 {
+		t.Errorf("expected to find a span in a ContextFunc, got %v", found)
+	}
+}
+
+// describe returns the name of the tag with its ID and classes, or its name attribute for inputs.
+func describe(t tag.Interface) string {
+	desc := t.TagName()
+	for name, value := range t.Attributes() {
+		switch name {
+		case `id`:
+			desc += `#` + value
+		case `class`:
+			desc += `.` + strings.ReplaceAll(value, ` `, `.`)
+		case `name`:
+			desc += `[name=` + value + `]`
+		}
+	}
+	return desc
+}
@@ -0,0 +1,165 @@
+package html
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Render renders content with the context of the request and writes it as a complete HTML response with the status,
+// which is what most handlers need:
+//
+//   - Content-Type is set for HTML unless the handler already set it, and Content-Length is always set.
+//   - The response is compressed with gzip or deflate if the client accepts either and it is large enough to benefit.
+//   - A successful response has a strong ETag computed from the rendered HTML, and a GET or HEAD request with a
+//     matching If-None-Match header gets a 304 Not Modified response without a body.
+//
+// Caching headers like Cache-Control are left to the handler.  Render returns the error from writing the response, or
+// the error from the request context if it was cancelled before the content was rendered, in which case nothing is
+// written.
+func Render(w http.ResponseWriter, r *http.Request, status int, content ...Content) error {
+	ctx := r.Context()
+	buf := GetBuffer(DefaultChunkSize)
+	defer PutBuffer(buf)
+	*buf = AppendContext(ctx, (*buf)[:0], content...)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	body := *buf
+
+	h := w.Header()
+	if h.Get(`Content-Type`) == `` {
+		h.Set(`Content-Type`, `text/html; charset=utf-8`)
+	}
+	h.Add(`Vary`, `Accept-Encoding`)
+	encoding := ``
+	if len(body) >= minCompressSize && h.Get(`Content-Encoding`) == `` {
+		encoding = acceptEncoding(r.Header.Get(`Accept-Encoding`))
+	}
+	if status == http.StatusOK {
+		etag := renderETag(body, encoding)
+		h.Set(`ETag`, etag)
+		safe := r.Method == http.MethodGet || r.Method == http.MethodHead
+		if safe && matchETag(r.Header.Get(`If-None-Match`), etag) {
+			h.Del(`Content-Type`)
+			w.WriteHeader(http.StatusNotModified)
+			return nil
+		}
+	}
+
+	if encoding != `` {
+		zbuf := GetBuffer(len(body) / 2)
+		defer PutBuffer(zbuf)
+		*zbuf = compress((*zbuf)[:0], body, encoding)
+		body = *zbuf
+		h.Set(`Content-Encoding`, encoding)
+	}
+	h.Set(`Content-Length`, strconv.Itoa(len(body)))
+	w.WriteHeader(status)
+	if r.Method == http.MethodHead {
+		return nil
+	}
+	_, err := w.Write(body)
+	return err
+}
+
+// minCompressSize is the smallest response that Render compresses, since compressing a response that already fits in
+// a single packet costs more than it saves.
+const minCompressSize = 1024
+
+// acceptEncoding returns the encoding supported by Render that the Accept-Encoding header prefers, preferring gzip if
+// the client has no preference, or an empty string if the client does not accept either.  As RFC 9110 requires, "*"
+// only applies to the encodings that the header does not name, so "gzip;q=0, *" refuses gzip.
+func acceptEncoding(header string) string {
+	var named [2]bool
+	var q [2]float64 // the quality of gzip and deflate, in order of preference.
+	wildcard := 0.0
+	for _, item := range strings.Split(header, `,`) {
+		name, params, _ := strings.Cut(item, `;`)
+		name = strings.ToLower(strings.TrimSpace(name))
+		quality := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), `q=`); ok {
+			var err error
+			if quality, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		switch name {
+		case `gzip`:
+			named[0], q[0] = true, quality
+		case `deflate`:
+			named[1], q[1] = true, quality
+		case `*`:
+			wildcard = quality
+		}
+	}
+	best, bestQ := ``, 0.0
+	for i, encoding := range [...]string{`gzip`, `deflate`} {
+		if !named[i] {
+			q[i] = wildcard
+		}
+		if q[i] > bestQ {
+			best, bestQ = encoding, q[i]
+		}
+	}
+	return best
+}
+
+// renderETag returns a strong ETag for the rendered HTML, which includes the encoding since each encoding is a
+// different representation of the response.
+func renderETag(body []byte, encoding string) string {
+	sum := sha256.Sum256(body)
+	etag := make([]byte, 0, 32)
+	etag = append(etag, '"')
+	etag = base64.RawURLEncoding.AppendEncode(etag, sum[:16])
+	if encoding != `` {
+		etag = append(etag, '-')
+		etag = append(etag, encoding...)
+	}
+	return string(append(etag, '"'))
+}
+
+// matchETag returns true if the If-None-Match header matches the ETag, using the weak comparison that RFC 9110 requires
+// for If-None-Match.
+func matchETag(header, etag string) bool {
+	for _, item := range strings.Split(header, `,`) {
+		item = strings.TrimSpace(item)
+		if item == `*` || strings.TrimPrefix(item, `W/`) == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// compress appends body compressed with the encoding to buf.
+func compress(buf, body []byte, encoding string) []byte {
+	out := bytes.NewBuffer(buf)
+	pool := &gzipWriters
+	if encoding == `deflate` {
+		pool = &zlibWriters
+	}
+	zw := pool.Get().(compressor)
+	zw.Reset(out)
+	_, _ = zw.Write(body) // writes to a bytes.Buffer cannot fail.
+	_ = zw.Close()
+	pool.Put(zw)
+	return out.Bytes()
+}
+
+// compressor is implemented by gzip.Writer and zlib.Writer, which can be reused with Reset.
+type compressor interface {
+	io.WriteCloser
+	Reset(w io.Writer)
+}
+
+var (
+	gzipWriters = sync.Pool{New: func() any { w, _ := gzip.NewWriterLevel(nil, gzip.BestSpeed); return w }}
+	zlibWriters = sync.Pool{New: func() any { w, _ := zlib.NewWriterLevel(nil, zlib.BestSpeed); return w }}
+)
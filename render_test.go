@@ -0,0 +1,102 @@
+package html_test
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+)
+
+func TestRender(t *testing.T) {
+	large := tag.New(`p`).Text(strings.Repeat(`All work and no play. `, 100))
+	render := func(method string, status int, content html.Content, headers ...string) *httptest.ResponseRecorder {
+		t.Helper()
+		r := httptest.NewRequest(method, `/`, nil)
+		for i := 0; i+1 < len(headers); i += 2 {
+			r.Header.Set(headers[i], headers[i+1])
+		}
+		w := httptest.NewRecorder()
+		if err := html.Render(w, r, status, content); err != nil {
+			t.Fatal(err)
+		}
+		return w
+	}
+	decode := func(w *httptest.ResponseRecorder) string {
+		t.Helper()
+		var rd io.Reader = w.Body
+		var err error
+		switch w.Header().Get(`Content-Encoding`) {
+		case `gzip`:
+			rd, err = gzip.NewReader(w.Body)
+		case `deflate`:
+			rd, err = zlib.NewReader(w.Body)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := io.ReadAll(rd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(p)
+	}
+
+	w := render(`GET`, 404, tag.New(`h1`).Text(`Not Found`), `Accept-Encoding`, `gzip`)
+	if w.Code != 404 || w.Body.String() != `<h1>Not Found</h1>` {
+		t.Errorf("expected a 404 with the content, got %v %q", w.Code, w.Body.String())
+	}
+	if h := w.Header(); h.Get(`Content-Type`) != `text/html; charset=utf-8` || h.Get(`Content-Length`) != `18` ||
+		h.Get(`Content-Encoding`) != `` || h.Get(`ETag`) != `` || h.Get(`Vary`) != `Accept-Encoding` {
+		t.Errorf("unexpected headers for a small error page: %v", h)
+	}
+
+	expect := string(html.Append(nil, large))
+	for _, test := range []struct{ accept, encoding string }{
+		{``, ``},
+		{`gzip, deflate, br`, `gzip`},
+		{`deflate, gzip;q=0.5`, `deflate`},
+		{`*`, `gzip`},
+		{`gzip;q=0, br`, ``},
+		{`gzip;q=0, *`, `deflate`},
+	} {
+		w := render(`GET`, 200, large, `Accept-Encoding`, test.accept)
+		if got := w.Header().Get(`Content-Encoding`); got != test.encoding {
+			t.Errorf("%q: expected encoding %q, got %q", test.accept, test.encoding, got)
+		}
+		if w.Header().Get(`Content-Length`) != strconv.Itoa(w.Body.Len()) {
+			t.Errorf("%q: content length %v does not match %v bytes", test.accept, w.Header().Get(`Content-Length`),
+				w.Body.Len())
+		}
+		if got := decode(w); got != expect {
+			t.Errorf("%q: expected %q, got %q", test.accept, expect, got)
+		}
+	}
+
+	w = render(`GET`, 200, large, `Accept-Encoding`, `gzip`)
+	etag := w.Header().Get(`ETag`)
+	if !strings.HasPrefix(etag, `"`) || !strings.HasSuffix(etag, `-gzip"`) {
+		t.Fatalf("expected a strong ETag for the gzip response, got %q", etag)
+	}
+	if identity := render(`GET`, 200, large).Header().Get(`ETag`); identity == etag || identity == `` {
+		t.Errorf("expected a different ETag for the identity response, got %q", identity)
+	}
+	w = render(`GET`, 200, large, `Accept-Encoding`, `gzip`, `If-None-Match`, `"other", W/`+etag)
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 || w.Header().Get(`ETag`) != etag {
+		t.Errorf("expected a 304 for a matching ETag, got %v with %d bytes", w.Code, w.Body.Len())
+	}
+	w = render(`POST`, 200, large, `Accept-Encoding`, `gzip`, `If-None-Match`, etag)
+	if w.Code != 200 {
+		t.Errorf("expected a POST to ignore If-None-Match, got %v", w.Code)
+	}
+	w = render(`HEAD`, 200, large)
+	if w.Code != 200 || w.Body.Len() != 0 || w.Header().Get(`Content-Length`) != strconv.Itoa(len(expect)) {
+		t.Errorf("expected a HEAD response without a body, got %v with %d bytes", w.Code, w.Body.Len())
+	}
+}
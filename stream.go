@@ -0,0 +1,193 @@
+package html
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// DefaultChunkSize is the chunk size used by WriteTo and by NewWriter when no size is given.
+const DefaultChunkSize = 16384
+
+// WriteTo renders content to w in bounded chunks instead of appending the whole document to one buffer, returning
+// the number of bytes written.  If w implements http.Flusher, it is flushed after each chunk so large pages start
+// reaching the client before they are fully rendered.
+func WriteTo(w io.Writer, content ...Content) (int64, error) {
+	return WriteToContext(context.Background(), w, content...)
+}
+
+// WriteToContext is like WriteTo, but passes ctx to any ContextContent and stops with ctx.Err() if ctx is cancelled
+// before rendering is complete.
+func WriteToContext(ctx context.Context, w io.Writer, content ...Content) (int64, error) {
+	buf := GetBuffer(DefaultChunkSize)
+	defer PutBuffer(buf)
+	sw := newWriter(ctx, w, *buf, DefaultChunkSize)
+	err := sw.Render(content...)
+	if err == nil {
+		err = sw.Flush()
+	}
+	*buf = sw.buf // rendering may have grown the buffer.
+	return sw.Written(), err
+}
+
+// NewWriter returns a Writer that renders content to w, writing a chunk whenever more than size bytes are pending.
+// If size is zero or negative, DefaultChunkSize is used.  If w implements http.Flusher, it will be flushed after each
+// chunk is written.
+//
+// The caller must call Flush after rendering to write the final chunk.
+func NewWriter(w io.Writer, size int) *Writer {
+	return NewWriterContext(context.Background(), w, size)
+}
+
+// NewWriterContext is like NewWriter, but the Writer passes ctx to any ContextContent it renders and stops rendering
+// with ctx.Err() once ctx is cancelled.
+func NewWriterContext(ctx context.Context, w io.Writer, size int) *Writer {
+	if size <= 0 {
+		size = DefaultChunkSize
+	}
+	return newWriter(ctx, w, make([]byte, 0, size), size)
+}
+
+func newWriter(ctx context.Context, w io.Writer, buf []byte, size int) *Writer {
+	sw := &Writer{ctx: ctx, buf: buf, size: size, out: w}
+	if f, ok := w.(http.Flusher); ok {
+		sw.flusher = f
+	}
+	return sw
+}
+
+// A Writer renders content to an io.Writer in bounded chunks.  Content that implements Streamer is walked piece by
+// piece, while other content is appended to the pending chunk as a whole.  Once a write fails, the Writer keeps
+// returning the same error.
+type Writer struct {
+	ctx     context.Context
+	buf     []byte
+	size    int
+	out     io.Writer
+	flusher http.Flusher
+	written int64
+	err     error
+}
+
+// A Streamer is Content that can render itself to a Writer in pieces, so the Writer does not need to hold the entire
+// rendered content at once.  Group, HTML, Func and ContextFunc implement Streamer, as do the tags produced by the tag
+// package.
+type Streamer interface {
+	Content
+	StreamHTML(w *Writer) error
+}
+
+// Render renders each item of content to the writer, writing chunks as they fill up.
+func (w *Writer) Render(content ...Content) error {
+	for _, item := range content {
+		if w.err != nil {
+			return w.err
+		}
+		if err := w.ctx.Err(); err != nil {
+			w.err = err
+			return err
+		}
+		if s, ok := item.(Streamer); ok {
+			if err := s.StreamHTML(w); err != nil {
+				return err
+			}
+			continue
+		}
+		w.buf = AppendContext(w.ctx, w.buf, item)
+		w.spill()
+	}
+	return w.err
+}
+
+// Context returns the context used to render content, which is context.Background() unless the Writer was created
+// with NewWriterContext.
+func (w *Writer) Context() context.Context { return w.ctx }
+
+// Append calls fn to append to the pending chunk, which is useful for content that writes markup around its
+// children, like the start and end of a tag.
+func (w *Writer) Append(fn func(buf []byte) []byte) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.buf = fn(w.buf)
+	w.spill()
+	return w.err
+}
+
+// Write implements io.Writer by adding p to the pending chunk.  If p is larger than a chunk, it is written directly
+// after the pending chunk.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if len(w.buf)+len(p) <= w.size {
+		w.buf = append(w.buf, p...)
+		w.spill()
+		return len(p), w.err
+	}
+	if err := w.Flush(); err != nil {
+		return 0, err
+	}
+	if len(p) < w.size {
+		w.buf = append(w.buf, p...)
+		return len(p), nil
+	}
+	if err := w.write(p); err != nil {
+		return 0, err
+	}
+	w.flush()
+	return len(p), nil
+}
+
+// Flush writes the pending chunk, if any, and flushes the underlying writer if it is a http.Flusher.
+func (w *Writer) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	if len(w.buf) == 0 {
+		return nil
+	}
+	if err := w.write(w.buf); err != nil {
+		return err
+	}
+	w.buf = w.buf[:0]
+	w.flush()
+	return nil
+}
+
+// Written returns the number of bytes written to the underlying writer so far.
+func (w *Writer) Written() int64 { return w.written }
+
+func (w *Writer) spill() {
+	if len(w.buf) >= w.size {
+		_ = w.Flush()
+	}
+}
+
+func (w *Writer) write(p []byte) error {
+	n, err := w.out.Write(p)
+	w.written += int64(n)
+	if err == nil && n < len(p) {
+		err = io.ErrShortWrite
+	}
+	w.err = err
+	return err
+}
+
+func (w *Writer) flush() {
+	if w.flusher != nil {
+		w.flusher.Flush()
+	}
+}
+
+// StreamHTML implements Streamer by rendering each element of the group in turn.
+func (group Group) StreamHTML(w *Writer) error { return w.Render(group...) }
+
+// StreamHTML implements Streamer by writing the HTML without copying it into the pending chunk if it is large.
+func (html HTML) StreamHTML(w *Writer) error {
+	_, err := w.Write(html)
+	return err
+}
+
+// StreamHTML implements Streamer by calling the function and rendering the content it returns.
+func (fn Func) StreamHTML(w *Writer) error { return w.Render(fn()) }
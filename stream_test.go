@@ -0,0 +1,66 @@
+package html_test
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+)
+
+func TestWriteTo(t *testing.T) {
+	rows := make([]int, 1000)
+	for i := range rows {
+		rows[i] = i
+	}
+	table := tag.New(`table`).Add(html.Map(rows, func(i int) html.Content {
+		return tag.New(`tr`).Add(tag.New(`td`).Text(i), html.Func(func() html.Content {
+			return tag.New(`td`).Text(strconv.Itoa(i * i))
+		}))
+	}))
+	expect := html.Append(nil, table)
+
+	var chunks chunkRecorder
+	w := html.NewWriter(&chunks, 256)
+	if err := w.Render(table); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if got := bytes.Join(chunks, nil); !bytes.Equal(got, expect) {
+		t.Errorf("streamed output differs from appended output:\n%s\n%s", got, expect)
+	}
+	if w.Written() != int64(len(expect)) {
+		t.Errorf("wrote %v bytes, expected %v", w.Written(), len(expect))
+	}
+	if len(chunks) < 2 {
+		t.Errorf("expected output to be written in several chunks, got %v", len(chunks))
+	}
+	for i, chunk := range chunks {
+		if len(chunk) > 512 {
+			t.Errorf("chunk %v is %v bytes, expected it to stay near 256", i, len(chunk))
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	n, err := html.WriteTo(rec, table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(expect)) || !bytes.Equal(rec.Body.Bytes(), expect) {
+		t.Errorf("WriteTo wrote %v bytes, expected %v", n, len(expect))
+	}
+	if !rec.Flushed {
+		t.Error("expected WriteTo to flush the response")
+	}
+}
+
+type chunkRecorder [][]byte
+
+func (rec *chunkRecorder) Write(p []byte) (int, error) {
+	*rec = append(*rec, append([]byte(nil), p...))
+	return len(p), nil
+}
@@ -0,0 +1,167 @@
+package tag
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/internal/aria"
+)
+
+func (t tag) Attr(name string) (string, bool) {
+	switch name {
+	case `id`:
+		return t.id, t.id != ``
+	case `class`:
+		return strings.Join(t.classes, ` `), len(t.classes) > 0
+	}
+	if i := t.indexAttr(name); i >= 0 {
+		return t.attributes[i].tail, true
+	}
+	return ``, false
+}
+
+func (t tag) Attributes() iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		if t.id != `` && !yield(`id`, t.id) {
+			return
+		}
+		if len(t.classes) > 0 && !yield(`class`, strings.Join(t.classes, ` `)) {
+			return
+		}
+		for _, attr := range t.attributes {
+			if attr.fragment {
+				continue
+			}
+			if !yield(attr.head, attr.tail) {
+				return
+			}
+		}
+	}
+}
+
+func (t tag) Unset(names ...string) Interface {
+	for _, name := range names {
+		switch name {
+		case `id`:
+			t.id = ``
+			continue
+		case `class`:
+			t.classes = nil
+			continue
+		}
+		if i := t.indexAttr(name); i >= 0 {
+			t.attributes = slices.Delete(slices.Clone(t.attributes), i, i+1)
+		}
+	}
+	return t
+}
+
+func (t tag) RemoveClass(classes ...string) Interface {
+	var kept []string
+	for _, field := range t.classes {
+		for class := range strings.FieldsSeq(field) {
+			if !slices.Contains(classes, class) {
+				kept = append(kept, class)
+			}
+		}
+	}
+	t.classes = kept
+	return t
+}
+
+func (t tag) SetIf(cond bool, attribute string, values ...any) Interface {
+	if !cond {
+		return t
+	}
+	return t.Set(attribute, values...)
+}
+
+func (t tag) Toggle(attribute string, on bool) Interface {
+	if on {
+		return t.Set(attribute)
+	}
+	return t.Unset(attribute)
+}
+
+// indexAttr returns the index of the named attribute, or -1 if it is not set.
+func (t tag) indexAttr(name string) int {
+	for i, attr := range t.attributes {
+		if attr.head == name && !attr.fragment {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t tag) Data(data map[string]any) Interface {
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		name := `data-` + key
+		if !html.ValidAttrName(name) {
+			panic(fmt.Errorf(`%q is not a valid data attribute name`, name))
+		}
+		switch value := data[key].(type) {
+		case string, html.HTML, html.URL, html.JS, html.CSS:
+			t = t.Set(name, value).(tag)
+		default:
+			js, err := json.Marshal(value)
+			if err != nil {
+				panic(err)
+			}
+			t = t.Set(name, string(js)).(tag)
+		}
+	}
+	return t
+}
+
+func (t tag) Aria(name string, value any) Interface {
+	if !strings.HasPrefix(name, `aria-`) {
+		name = `aria-` + name
+	}
+	attr, ok := aria.Lookup(name)
+	if !ok {
+		panic(fmt.Errorf(`%q is not a WAI-ARIA attribute`, name))
+	}
+	text := fmt.Sprint(value)
+	if !attr.Valid(text) {
+		panic(fmt.Errorf(`%q is not a valid value for %v`, text, name))
+	}
+	return t.Set(name, text)
+}
+
+func (t tag) Style(properties map[string]string) Interface {
+	var style strings.Builder
+	for _, property := range slices.Sorted(maps.Keys(properties)) {
+		if !validProperty(property) {
+			panic(fmt.Errorf(`%q is not a valid CSS property name`, property))
+		}
+		if style.Len() > 0 {
+			style.WriteString(`; `)
+		}
+		style.WriteString(property)
+		style.WriteString(`: `)
+		style.WriteString(string(html.SafeCSS(properties[property])))
+	}
+	return t.Set(`style`, html.CSS(style.String()))
+}
+
+// validProperty returns true if the name is a CSS property name, including custom properties like "--accent".
+func validProperty(name string) bool {
+	if name == `` || name == `-` || name == `--` {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		ch := name[i]
+		switch {
+		case 'a' <= ch && ch <= 'z', 'A' <= ch && ch <= 'Z', ch == '-', ch == '_':
+		case i > 0 && '0' <= ch && ch <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,280 @@
+package tag
+
+import (
+	"context"
+	"iter"
+	"strings"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/internal/whatwg"
+)
+
+// appendMinified appends the tag like AppendHTMLContext for a context from html.Minify; if omitEnd is true, the end
+// tag is left off because the content that follows the tag implies it.
+func (t tag) appendMinified(ctx context.Context, buf []byte, omitEnd bool) []byte {
+	buf = t.appendMinifiedStart(buf)
+	if t.kind == rawTextKind {
+		buf = t.appendRawText(buf)
+	} else {
+		ctx = t.preserveContext(ctx)
+		for item, omit := range t.minified(preserved(ctx)) {
+			if child, ok := item.(tag); ok {
+				buf = child.appendMinified(ctx, buf, omit)
+			} else {
+				buf = html.AppendContext(ctx, buf, item)
+			}
+		}
+	}
+	if omitEnd {
+		return buf
+	}
+	return t.appendEnd(buf)
+}
+
+// streamMinified writes the tag like StreamHTML for a writer with a context from html.Minify.
+func (t tag) streamMinified(w *html.Writer, omitEnd bool) error {
+	ctx := w.Context()
+	if t.kind == rawTextKind || t.preserves() || preserved(ctx) {
+		// the content of these tags is rarely large, and the writer's context cannot say whitespace is preserved.
+		return w.Append(func(buf []byte) []byte { return t.appendMinified(ctx, buf, omitEnd) })
+	}
+	if err := w.Append(t.appendMinifiedStart); err != nil {
+		return err
+	}
+	for item, omit := range t.minified(false) {
+		var err error
+		if child, ok := item.(tag); ok {
+			err = child.streamMinified(w, omit)
+		} else {
+			err = w.Render(item)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if omitEnd {
+		return nil
+	}
+	return w.Append(t.appendEnd)
+}
+
+// appendMinifiedStart appends the start tag, leaving attribute values unquoted when that is safe.
+func (t tag) appendMinifiedStart(buf []byte) []byte {
+	buf = append(buf, '<')
+	buf = append(buf, t.name...)
+	if t.id != `` {
+		buf = append(buf, ` id=`...)
+		buf = appendMinifiedValue(buf, t.id)
+	}
+	if len(t.classes) > 0 {
+		buf = append(buf, ` class=`...)
+		buf = appendMinifiedValue(buf, strings.Join(t.classes, ` `))
+	}
+	for _, attr := range t.attributes {
+		buf = append(buf, ' ')
+		buf = append(buf, attr.head...)
+		if len(attr.tail) > 0 {
+			buf = append(buf, '=')
+			buf = appendMinifiedValue(buf, attr.tail)
+		}
+	}
+	return append(buf, '>')
+}
+
+// appendMinifiedValue appends an attribute value without quotes if it does not contain anything that would end an
+// unquoted value or need an entity, otherwise it is quoted and escaped as usual.
+func appendMinifiedValue(buf []byte, value string) []byte {
+	if !strings.ContainsAny(value, " \t\n\r\f\"'=<>`&") {
+		return append(buf, value...)
+	}
+	buf = append(buf, '\'')
+	buf = html.AppendAttrText(buf, value)
+	return append(buf, '\'')
+}
+
+// preserveKey marks a context used to render the content of a tag where whitespace is significant, like "pre".
+type preserveKey struct{}
+
+func preserved(ctx context.Context) bool {
+	preserve, _ := ctx.Value(preserveKey{}).(bool)
+	return preserve
+}
+
+// preserveContext returns a context that preserves whitespace if the tag needs it, which reaches tags nested in
+// content like html.Func.
+func (t tag) preserveContext(ctx context.Context) context.Context {
+	if t.preserves() && !preserved(ctx) {
+		return context.WithValue(ctx, preserveKey{}, true)
+	}
+	return ctx
+}
+
+// preserves returns true if whitespace in the content of the tag is significant.
+func (t tag) preserves() bool {
+	switch t.name {
+	case `pre`, `textarea`, `listing`, `plaintext`:
+		return true
+	}
+	return false
+}
+
+// minified iterates over the content of the tag as it should be rendered when minified.  Groups are flattened, text
+// has runs of whitespace collapsed to a single space and is dropped if it only separates blocks, and tags are paired
+// with true if their end tag can be omitted.  Tags wrapped by other types, like the elements in the el package, are
+// rendered as other content, which still minifies them but always keeps their end tags.
+func (t tag) minified(preserve bool) iter.Seq2[html.Content, bool] {
+	items := flatten(t.content)
+	return func(yield func(html.Content, bool) bool) {
+		for i, item := range items {
+			switch item := item.(type) {
+			case html.Text:
+				if !preserve {
+					text := collapse(string(item))
+					if text == `` || text == ` ` && !inlineAt(items, i-1) && !inlineAt(items, i+1) {
+						continue
+					}
+					item = html.Text(text)
+				}
+				if !yield(item, false) {
+					return
+				}
+			case tag:
+				if !yield(item, !preserve && omitEnd(item.name, t.name, nextName(items, i))) {
+					return
+				}
+			default:
+				if !yield(item, false) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// flatten returns the content with any html.Group replaced by its content, only copying it if there is a group.
+func flatten(content []html.Content) []html.Content {
+	for i, item := range content {
+		if _, ok := item.(html.Group); ok {
+			flat := append([]html.Content(nil), content[:i]...)
+			return appendFlat(flat, content[i:])
+		}
+	}
+	return content
+}
+
+func appendFlat(flat []html.Content, content []html.Content) []html.Content {
+	for _, item := range content {
+		if group, ok := item.(html.Group); ok {
+			flat = appendFlat(flat, group)
+		} else {
+			flat = append(flat, item)
+		}
+	}
+	return flat
+}
+
+// collapse replaces each run of whitespace with a single space.
+func collapse(text string) string {
+	if !strings.ContainsAny(text, " \t\n\r\f") {
+		return text
+	}
+	var buf strings.Builder
+	buf.Grow(len(text))
+	space := false
+	for i := 0; i < len(text); i++ {
+		switch ch := text[i]; ch {
+		case ' ', '\t', '\n', '\r', '\f':
+			if !space {
+				buf.WriteByte(' ')
+			}
+			space = true
+		default:
+			buf.WriteByte(ch)
+			space = false
+		}
+	}
+	return buf.String()
+}
+
+// inlineAt returns true if the content at index i might be rendered inline with text, so whitespace next to it is
+// significant.  Only known block elements, like "div" and "li", are treated as blocks.
+func inlineAt(items []html.Content, i int) bool {
+	if i < 0 || i >= len(items) {
+		return false
+	}
+	item, ok := items[i].(tag)
+	if !ok {
+		return true
+	}
+	elem, ok := whatwg.Lookup(item.name)
+	return !ok || elem.Inline
+}
+
+// nextName returns the name of the tag that follows the content at index i, skipping whitespace, an empty string if
+// nothing follows it, or "?" if something other than a tag follows it.
+func nextName(items []html.Content, i int) string {
+	for _, item := range items[i+1:] {
+		switch item := item.(type) {
+		case html.Text:
+			if strings.TrimSpace(string(item)) != `` {
+				return `?`
+			}
+		case tag:
+			return item.name
+		default:
+			return `?`
+		}
+	}
+	return ``
+}
+
+// omitEnd returns true if the end tag of an element can be omitted when it is followed by the next element in its
+// parent, following the optional tag rules in the HTML spec.  The next element is empty if the element is the last
+// content of its parent.
+func omitEnd(name, parent, next string) bool {
+	last := next == ``
+	switch name {
+	case `li`:
+		return last || next == `li`
+	case `dt`:
+		return next == `dt` || next == `dd`
+	case `dd`:
+		return last || next == `dd` || next == `dt`
+	case `p`:
+		if last {
+			return !keepsEndOfP[parent] && !strings.Contains(parent, `-`)
+		}
+		return whatwg.EndsParagraph(next)
+	case `rt`, `rp`:
+		return last || next == `rt` || next == `rp`
+	case `optgroup`:
+		return last || next == `optgroup` || next == `hr`
+	case `option`:
+		return last || next == `option` || next == `optgroup` || next == `hr`
+	case `thead`:
+		return next == `tbody` || next == `tfoot`
+	case `tbody`:
+		return last || next == `tbody` || next == `tfoot`
+	case `tfoot`:
+		return last
+	case `tr`:
+		return last || next == `tr`
+	case `td`, `th`:
+		return last || next == `td` || next == `th`
+	case `head`, `body`, `html`, `colgroup`, `caption`:
+		// these can be omitted unless they are followed by whitespace or a comment.
+		return next != `?`
+	}
+	return false
+}
+
+// keepsEndOfP lists the parents where the end tag of a final "p" cannot be omitted.
+var keepsEndOfP = setOf(`a`, `audio`, `del`, `ins`, `map`, `noscript`, `video`)
+
+func setOf(names ...string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
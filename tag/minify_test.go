@@ -0,0 +1,52 @@
+package tag_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+)
+
+func TestMinify(t *testing.T) {
+	ctx := html.Minify(context.Background())
+	test := func(name, expect string, content html.Content) {
+		t.Helper()
+		result := string(html.AppendContext(ctx, nil, content))
+		if result != expect {
+			t.Errorf("%v: expected %q, got %q", name, expect, result)
+		}
+		var buf bytes.Buffer
+		if _, err := html.WriteToContext(ctx, &buf, content); err != nil {
+			t.Errorf("%v: unexpected error %v", name, err)
+		} else if buf.String() != expect {
+			t.Errorf("%v: expected %q when streamed, got %q", name, expect, buf.String())
+		}
+	}
+	test(`attributes`, `<input id=name class='a b' type=text placeholder='Your Name' value='&amp;'>`,
+		tag.New(`input#name.a.b[type=text]`).Set(`placeholder`, `Your Name`).Set(`value`, `&`))
+	test(`list`, `<ul><li>One<li>Two</ul>`,
+		tag.New(`ul`).Add(html.Text("\n  "), tag.New(`li`).Text(`One`), html.Text("\n  "),
+			tag.New(`li`).Text(`Two`), html.Text("\n")))
+	test(`paragraphs`, `<div><p>One<p>Two <b>bold</b> text<div>Three</div></div>`,
+		tag.New(`div`).Add(
+			tag.New(`p`).Text(`One`),
+			tag.New(`p`).Text("Two\n   ").Add(tag.New(`b`).Text(`bold`)).Text(` text`),
+			tag.New(`div`).Text(`Three`),
+		))
+	test(`p in a`, `<a href=/><p>One</p></a>`, tag.New(`a[href=/]`).Add(tag.New(`p`).Text(`One`)))
+	test(`p before span`, `<div><p>One</p><span>Two</span></div>`,
+		tag.New(`div`).Add(tag.New(`p`).Text(`One`), tag.New(`span`).Text(`Two`)))
+	test(`table`, `<table><tr><td>A<td>B<tr><td>C</table>`,
+		tag.New(`table`).Add(
+			tag.New(`tr`).Add(tag.New(`td`).Text(`A`), tag.New(`td`).Text(`B`)),
+			html.Group{tag.New(`tr`).Add(tag.New(`td`).Text(`C`))},
+		))
+	test(`pre`, "<div><pre>  a\n  <b>b  c</b></pre></div>",
+		tag.New(`div`).Add(html.Text("\n "), tag.New(`pre`).Text("  a\n  ").Add(tag.New(`b`).Text(`b  c`))))
+	test(`textarea`, "<textarea>  a\n  b</textarea>", tag.New(`textarea`).Text("  a\n  b"))
+	test(`script`, "<script>if (a)\n  b()</script>", tag.New(`script`).Text("if (a)\n  b()"))
+	test(`opaque`, `<ul><li>One</li><!-- x --><li>Two</ul>`,
+		tag.New(`ul`).Add(tag.New(`li`).Text(`One`), html.HTML(`<!-- x -->`), tag.New(`li`).Text(`Two`)))
+}
@@ -0,0 +1,164 @@
+package tag
+
+import (
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/internal/scan"
+	"github.com/swdunlop/html-go/internal/whatwg"
+)
+
+// Parse reads HTML from r and converts it into content: elements become tags built by this package, text becomes
+// html.Text, and comments and doctypes become html.HTML.  The result can be inspected with TagName, Attributes and
+// Children, changed using the methods of Interface, and rendered again with this package's escaping rules.
+//
+// Like a browser, Parse does not reject malformed HTML.  It closes elements that HTML allows to be implied, like a "p"
+// followed by a "div", or an "li" followed by another "li", ignores end tags that do not match an open element, and
+// closes any elements left open at the end of the input.  Unlike a browser, Parse does not add missing "html", "head"
+// or "body" elements, so it can be used for snippets as well as whole documents.
+//
+// Element and attribute names are converted to lowercase, except inside "svg" and "math", where case matters.
+// Attribute values are trusted, like html.HTML, since they came from markup that was already trusted; they are not
+// sanitized, but are escaped again when the tag is rendered.  Attributes with names that this package cannot render
+// are dropped.  Parse only returns an error if r does.
+func Parse(r io.Reader) (html.Group, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var p parser
+	s := scan.New(string(src))
+	for tok, ok := s.Next(); ok; tok, ok = s.Next() {
+		p.token(tok)
+	}
+	p.closeTo(0)
+	return p.root, nil
+}
+
+// parser builds content from tokens, keeping a stack of open elements like the tree construction stage in the HTML
+// spec, but with far fewer rules.
+type parser struct {
+	root  html.Group
+	stack []*tag
+}
+
+func (p *parser) token(tok scan.Token) {
+	switch tok.Kind {
+	case scan.Text:
+		p.add(html.Text(tok.Data))
+	case scan.Comment:
+		p.add(html.HTML(`<!--` + strings.ReplaceAll(tok.Data, `-->`, `--&gt;`) + `-->`))
+	case scan.Doctype:
+		p.add(html.HTML(`<!DOCTYPE ` + tok.Data + `>`))
+	case scan.StartTag:
+		p.start(tok)
+	case scan.EndTag:
+		p.end(tok)
+	}
+}
+
+// add adds content to the innermost open element, or to the result if no element is open.
+func (p *parser) add(content html.Content) {
+	if n := len(p.stack); n > 0 {
+		p.stack[n-1].content = append(p.stack[n-1].content, content)
+	} else {
+		p.root = append(p.root, content)
+	}
+}
+
+func (p *parser) start(tok scan.Token) {
+	name := tok.Name
+	foreign := p.foreign()
+	if !foreign {
+		name = strings.ToLower(name)
+		p.implyEnd(name)
+		foreign = name == `svg` || name == `math`
+	}
+	t := &tag{name: name}
+	for _, attr := range tok.Attributes {
+		head := attr.Name
+		if !foreign {
+			head = strings.ToLower(head)
+		}
+		switch {
+		case !html.ValidAttrName(head):
+		case head == `id`:
+			t.id = attr.Value
+		case head == `class`:
+			if attr.Value != `` {
+				t.classes = []string{attr.Value}
+			}
+		default:
+			t.attributes = append(t.attributes, attribute{head: head, tail: attr.Value})
+		}
+	}
+	t.determineKind()
+	switch {
+	case t.kind == voidKind && !foreign:
+		p.add(*t)
+	case tok.SelfClosing && foreign:
+		p.add(*t)
+	default:
+		p.stack = append(p.stack, t)
+	}
+}
+
+func (p *parser) end(tok scan.Token) {
+	for i := len(p.stack) - 1; i >= 0; i-- {
+		if strings.EqualFold(p.stack[i].name, tok.Name) {
+			p.closeTo(i)
+			return
+		}
+	}
+	// like browsers, we ignore end tags that do not match an open element.
+}
+
+// closeTo closes each open element from the innermost to the element at index i in the stack.
+func (p *parser) closeTo(i int) {
+	for len(p.stack) > i {
+		n := len(p.stack) - 1
+		t := p.stack[n]
+		p.stack = p.stack[:n]
+		p.add(*t)
+	}
+}
+
+// foreign returns true if an "svg" or "math" element is open.
+func (p *parser) foreign() bool {
+	for _, t := range p.stack {
+		if elem, ok := whatwg.Lookup(t.name); ok && elem.Foreign {
+			return true
+		}
+	}
+	return false
+}
+
+// implyEnd closes elements whose end tag is implied by a start tag, like a "p" before a "div".
+func (p *parser) implyEnd(name string) {
+	for _, end := range whatwg.ImpliedEnds(name) {
+		if end.Current {
+			if n := len(p.stack); n > 0 && slices.Contains(end.Names, p.stack[n-1].name) {
+				p.closeTo(n - 1)
+			}
+			continue
+		}
+		p.closeNearest(end.Names, end.Boundaries)
+	}
+}
+
+// closeNearest closes the innermost open element with one of the names, unless one of the boundaries, or an element
+// that always limits the scope of implied end tags, like "table", is open inside it.
+func (p *parser) closeNearest(names, boundaries []string) {
+	for i := len(p.stack) - 1; i >= 0; i-- {
+		name := p.stack[i].name
+		switch {
+		case slices.Contains(names, name):
+			p.closeTo(i)
+			return
+		case whatwg.Scope(name), slices.Contains(boundaries, name):
+			return
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package tag_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+)
+
+func TestParse(t *testing.T) {
+	test := func(name, src, expect string) {
+		t.Helper()
+		group, err := tag.Parse(strings.NewReader(src))
+		if err != nil {
+			t.Errorf("%v: unexpected error %v", name, err)
+			return
+		}
+		result := string(group.AppendHTML(nil))
+		if result != expect {
+			t.Errorf("%v: expected %q, got %q", name, expect, result)
+		}
+	}
+	test(`simple`, `<p class="note">Hello, <b>World</b>!</p>`, `<p class='note'>Hello, <b>World</b>!</p>`)
+	test(`attributes`, `<INPUT TYPE=checkbox name="it's" checked disabled=disabled value='a &amp; b'>`,
+		`<input type='checkbox' name='it&apos;s' checked disabled='disabled' value='a &amp; b'>`)
+	test(`entities`, `<p>&lt;b&gt; &copy; &#169; &amp</p>`, `<p>&lt;b&gt; © © &amp;</p>`)
+	test(`doctype`, `<!doctype html><!-- hi --><title>A &amp; B</title>`,
+		`<!DOCTYPE html><!-- hi --><title>A &amp; B</title>`)
+	test(`script`, `<script>if (a < b && c) { x = "</p>" }</script>`,
+		`<script>if (a < b && c) { x = "</p>" }</script>`)
+	test(`implied p`, `<p>One<p>Two<div>Three</div>`, `<p>One</p><p>Two</p><div>Three</div>`)
+	test(`implied li`, `<ul><li>One<li>Two<ul><li>Nested</ul></ul>`,
+		`<ul><li>One</li><li>Two<ul><li>Nested</li></ul></li></ul>`)
+	test(`implied table`, `<table><tr><td>A<td>B<tr><td>C</table>`,
+		`<table><tr><td>A</td><td>B</td></tr><tr><td>C</td></tr></table>`)
+	test(`stray end tag`, `<div>A</span>B</div>`, `<div>AB</div>`)
+	test(`unclosed`, `<div><span>A`, `<div><span>A</span></div>`)
+	test(`self closing`, `<br/><div/>A`, `<br><div>A</div>`)
+	test(`svg`, `<svg viewBox="0 0 10 10"><path d="M0 0"/></svg>`,
+		`<svg viewBox='0 0 10 10'><path d='M0 0'></path></svg>`)
+	test(`text`, `a < b > c`, `a &lt; b &gt; c`)
+
+	group, err := tag.Parse(strings.NewReader(`<a href="/x">X</a>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	link, ok := group[0].(tag.Interface)
+	if !ok || link.TagName() != `a` || len(link.Children()) != 1 || link.Children()[0] != html.Text(`X`) {
+		t.Fatalf("expected a tag with one text child, got %#v", group[0])
+	}
+	result := string(link.Set(`target`, `_blank`).AppendHTML(nil))
+	if expect := `<a href='/x' target='_blank'>X</a>`; result != expect {
+		t.Errorf("expected %q, got %q", expect, result)
+	}
+}
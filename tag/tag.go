@@ -3,10 +3,15 @@
 package tag
 
 import (
+	"context"
 	"fmt"
+	"iter"
+	"slices"
 	"strings"
 
 	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/internal/selector"
+	"github.com/swdunlop/html-go/internal/whatwg"
 )
 
 // New will construct a new HTML tag using the provided string like a CSS selector -- parsing out the tag name,
@@ -14,14 +19,38 @@ import (
 // omitted from the produced tag.
 //
 // Attributes can also be specified using a CSS-like syntax appending "[name]" for boolean attributes or "[name=value]"
-// for attributes with values.  Multiple attributes can be added this way, wrapping each one in square brackets.
+// for attributes with values.  Multiple attributes can be added this way, wrapping each one in square brackets.  If a
+// value contains spaces, quotes or "]", it must be quoted like a CSS string, such as `[placeholder="Your Name"]` or
+// `[title='Say "Hi"']`; quoted values may also use CSS escapes, like `\"` or `\20`.
+//
+// New will panic if the selector is not valid; use Compile to check a selector without panicking.
 //
 // Content can be added to the tag by passing it as variadic arguments to New.  If the tag is a "void" tag, like "link",
 // then it cannot actually have any content.  Instead, the additional content will be appended after the tag.
 func New(selector string, content ...html.Content) Interface {
+	return MustCompile(selector).Add(content...)
+}
+
+// Compile parses a selector like New, returning an error describing the problem if the selector is not valid.  The
+// selector must start with a tag name made of ASCII letters, digits, hyphens and underscores, IDs and classes must
+// not be empty, and attribute values containing spaces, quotes or "]" must be quoted.
+//
+// Since each method returns a copy of the tag, the result can be compiled once, such as in a package variable, and
+// reused when rendering.
+func Compile(selector string) (Interface, error) {
 	var t tag
-	t.parseSelector(selector)
-	t.content = extend(t.content, content...)
+	if err := t.parseSelector(selector); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// MustCompile is like Compile, but panics if the selector is not valid.  New uses MustCompile to parse its selector.
+func MustCompile(selector string) Interface {
+	t, err := Compile(selector)
+	if err != nil {
+		panic(err)
+	}
 	return t
 }
 
@@ -31,24 +60,88 @@ type Interface interface {
 	// AppendHTML implements html.Content by appending the tag and its content to the buffer.
 	AppendHTML(buf []byte) []byte
 
+	// AppendHTMLContext implements html.ContextContent by appending the tag and its content to the buffer, passing ctx
+	// to any content that implements html.ContextContent, like content added with DeferContext.
+	AppendHTMLContext(ctx context.Context, buf []byte) []byte
+
+	// StreamHTML implements html.Streamer by writing the tag and its content to a html.Writer in pieces.
+	StreamHTML(w *html.Writer) error
+
+	// TagName returns the name of the tag, like "div".
+	TagName() string
+
+	// Children returns a copy of the content that was added to the tag, not including html.Attr fragments.
+	Children() []html.Content
+
+	// WithChildren will return a copy of the tag with its content replaced, following the same rules as Add.  With
+	// TagName, Attributes and Children, this implements html.Node, so tags can be walked with html.Walk and rebuilt
+	// with html.Transform.
+	WithChildren(children ...html.Content) html.Node
+
 	// ID will return the ID of the tag or an empty string if no ID was set.  If you want to set the ID of the tag,
 	// either specify it in the selector or use the "Set" method.
 	ID() string
 
 	// Class will append classes to the tag, but not remove the previous classes.  If you want to reset the set
-	// of classes, use the "Attribute" method.
+	// of classes, use the "Set" method.
 	Class(classes ...string) Interface
 
 	// Set will return a copy of the tag with additional attributes.  If the attribute was already set, the previous
 	// value will be removed.  If no values are provided, a "boolean" attribute is added, like the "defer" attribute of
 	// script.
+	//
+	// Values are sanitized depending on how the browser will use the attribute, like html/template; see
+	// html.AppendAttrValue for details.  Literal text after an "=" in the attribute, like Set(`href=/users/`, name), is
+	// trusted, as are values of type html.URL, html.JS and html.CSS in URL, event handler and style attributes.
 	Set(attribute string, values ...any) Interface
 
+	// Attr returns the value of an attribute and true if the attribute is set, including "id" and "class".  Boolean
+	// attributes have an empty value.  Attributes added as html.Attr fragments are not visible to Attr.
+	Attr(name string) (string, bool)
+
+	// Attributes iterates over the name and value of each attribute in the order they will be rendered, starting with
+	// "id" and "class" if they are set.  Attributes added as html.Attr fragments are not included.
+	Attributes() iter.Seq2[string, string]
+
+	// Unset will return a copy of the tag without the named attributes, which may include "id" and "class".
+	Unset(attributes ...string) Interface
+
+	// RemoveClass will return a copy of the tag without the provided classes.
+	RemoveClass(classes ...string) Interface
+
+	// SetIf will return a copy of the tag with the attribute set like Set if cond is true, otherwise it will return
+	// the tag unchanged.
+	SetIf(cond bool, attribute string, values ...any) Interface
+
+	// Toggle will return a copy of the tag with a boolean attribute, like "disabled", set if on is true and removed
+	// if on is false.
+	Toggle(attribute string, on bool) Interface
+
+	// Data will return a copy of the tag with a "data-" attribute for each key in the map, in order by key.  String
+	// values are used as-is, while other values are encoded as JSON, which is convenient for libraries like Datastar
+	// and Alpine that read state from data attributes.
+	Data(data map[string]any) Interface
+
+	// Aria will return a copy of the tag with a WAI-ARIA attribute, like Aria(`expanded`, false) or
+	// Aria(`aria-label`, `Close`).  This will panic if the name is not a WAI-ARIA 1.2 state or property, or if the
+	// value is not valid for that attribute, such as a value other than true or false for "aria-expanded".
+	Aria(name string, value any) Interface
+
+	// Style will return a copy of the tag with its style attribute replaced by the properties in the map, in order by
+	// property name.  Values are sanitized with html.SafeCSS, and this will panic if a property name is not valid.
+	Style(properties map[string]string) Interface
+
 	// Add will return a copy of the tag with additional content.  If the tag is a "void" tag, like "link", then it
 	// cannot actually have any content.  Instead, the additional content will be appended after the tag.
 	//
-	// If the tag is a "style" or "script", Add will fail unless the content is html.Text -- you can only add text
-	// due to HTML5 rules.
+	// If the tag is a "script" or "style", Add will panic unless the content is html.Text, or html.JS for script and
+	// html.CSS for style -- HTML5 does not decode entities in these tags, so their text is added verbatim, except
+	// that "</script", "<script" and "<!--" are escaped with a backslash so the text cannot end the tag early.
+	//
+	// If the tag is a "textarea" or "title", Add will panic unless the content is html.Text, since these tags cannot
+	// contain other tags.
+	//
+	// Attribute fragments of type html.Attr are added to the start tag instead of the content of the tag.
 	Add(content ...html.Content) Interface
 
 	// Text will use fmt.Sprint to coerce data into text and add it as HTML content to the tag.
@@ -60,6 +153,10 @@ type Interface interface {
 	// Defer will add a html.Func to the tag which is only called when the tag is appended to a buffer.  This will be
 	// called each time the tag is appended.
 	Defer(f func() html.Content) Interface
+
+	// DeferContext is like Defer, but the function is given the context the tag is rendered with, such as the request
+	// context.  When the tag is appended without a context, the function is given context.Background().
+	DeferContext(f func(ctx context.Context) html.Content) Interface
 }
 
 type tag struct {
@@ -68,96 +165,115 @@ type tag struct {
 	classes    []string
 	attributes []attribute
 	content    []html.Content
-	void       bool
+	kind       kind
 }
 
-func (t *tag) parseSelector(src string) {
-	defer t.determineVoid()
-	var id, class string
+// kind describes the content model of a tag.
+type kind uint8
+
+const (
+	normalKind  kind = iota
+	voidKind         // tags like "br" that have no content or end tag
+	rawTextKind      // "script" and "style", whose content is not escaped
+	rcdataKind       // "textarea" and "title", whose content is escaped text
+)
+
+func (t *tag) parseSelector(src string) error {
+	defer t.determineKind()
 	t.name = `div`
 	if src == "" {
-		return
+		return nil
 	}
-	pos := strings.IndexAny(src, "#.[")
-	if pos == -1 {
-		t.name = src
-		return
+	compound, err := selector.Parse(src)
+	if err != nil {
+		return err
 	}
-	if pos > 0 {
-		t.name = src[:pos]
+	if compound.Name != `` {
+		t.name = compound.Name
 	}
-	t.attributes = make([]attribute, 1) // preallocate space for id
-	src = src[pos:]
-	for len(src) > 0 {
-		switch src[0] {
-		case '#':
-			pos = strings.IndexAny(src[1:], ".[") + 1
-			if pos == 0 {
-				pos = len(src)
-			}
-			// TODO: reject invalid id names
-			id = src[1:pos]
-		case '.':
-			pos = strings.IndexAny(src[1:], "#.[") + 1
-			if pos == 0 {
-				pos = len(src)
-			}
-			if class != "" {
-				class += ` `
-			}
-			// TODO: reject invalid class names
-			class += src[1:pos]
-		case '[':
-			pos = strings.IndexByte(src[1:], ']') + 1
-			if pos == 0 {
-				panic(fmt.Errorf(`%q starts with [ but does not end with ]`, src))
-			}
-			// we ignore empty attributes, arguably we should panic.
-			if pos > 1 {
-				t.addLiteralAttribute(src[1:pos])
+	t.id = compound.ID
+	if len(compound.Classes) > 0 {
+		t.classes = []string{strings.Join(compound.Classes, ` `)}
+	}
+	if len(compound.Attributes) > 0 {
+		t.attributes = make([]attribute, len(compound.Attributes))
+		for i, attr := range compound.Attributes {
+			t.attributes[i].head = attr.Name
+			if attr.HasValue {
+				t.attributes[i].tail = attr.Value
 			}
-			pos++
 		}
-		src = src[pos:]
 	}
-	if class != "" {
-		t.classes = []string{class}
+	return nil
+}
+
+// determineKind uses the WHATWG element table to find the content model of the tag; unknown tags, like custom
+// elements, are treated as normal tags.
+func (t *tag) determineKind() {
+	elem, ok := whatwg.Lookup(t.name)
+	switch {
+	case !ok:
+	case elem.Void:
+		t.kind = voidKind
+	case elem.RawText:
+		t.kind = rawTextKind
+	case elem.RCDATA:
+		t.kind = rcdataKind
 	}
-	if id != "" {
-		t.id = id
+}
+
+func (t tag) AppendHTML(buf []byte) []byte { return t.AppendHTMLContext(context.Background(), buf) }
+
+func (t tag) AppendHTMLContext(ctx context.Context, buf []byte) []byte {
+	if html.SearchingFragment(ctx) {
+		return t.appendFragment(ctx, buf)
+	}
+	if html.Minified(ctx) {
+		return t.appendMinified(ctx, buf, false)
 	}
-	t.attributes = t.attributes[1:]
+	buf = t.appendStart(buf)
+	if t.kind == rawTextKind {
+		buf = t.appendRawText(buf)
+	} else {
+		buf = html.AppendContext(ctx, buf, t.content...)
+	}
+	return t.appendEnd(buf)
 }
 
-func (t *tag) addLiteralAttribute(src string) {
-	before, after, ok := strings.Cut(src, "=")
-	if !ok {
-		// assume a boolean attribute.
-		t.attributes = append(t.attributes, attribute{head: src})
-		return
+// StreamHTML implements html.Streamer by writing the start of the tag, its content, and then the end of the tag so
+// large content does not need to be buffered.
+func (t tag) StreamHTML(w *html.Writer) error {
+	if html.Minified(w.Context()) {
+		return t.streamMinified(w, false)
+	}
+	if err := w.Append(t.appendStart); err != nil {
+		return err
 	}
-	attribute := attribute{head: before}
-	tail := after
-	buf := make([]byte, 0, len(tail)+2)
-	buf = appendValueStr(buf, tail)
-	attribute.tail = string(buf)
-	if attribute.head == `id` {
-		t.id = attribute.tail
-		return // do not add id as an attribute, otherwise it will be duplicated.
+	var err error
+	if t.kind == rawTextKind {
+		err = w.Append(t.appendRawText)
+	} else {
+		err = w.Render(t.content...)
 	}
-	// append is safe here because we preallocate space for the attributes.
-	t.attributes = append(t.attributes, attribute)
+	if err != nil {
+		return err
+	}
+	return w.Append(t.appendEnd)
 }
 
-func (t *tag) determineVoid() {
-	switch t.name {
-	case `area`, `base`, `br`, `col`, `embed`, `hr`, `img`, `input`, `keygen`, `link`, `meta`, `param`, `source`,
-		`track`, `wbr`:
-		t.void = true
+// appendFragment renders the tag as usual if it is part of the fragment being searched for in ctx, otherwise it only
+// searches its content; see html.Fragment.
+func (t tag) appendFragment(ctx context.Context, buf []byte) []byte {
+	if inner, ok := html.MatchFragment(ctx, t.id); ok {
+		return t.AppendHTMLContext(inner, buf)
+	}
+	if t.kind == rawTextKind {
+		return buf
 	}
+	return html.AppendContext(ctx, buf, t.content...)
 }
 
-func (t tag) AppendHTML(buf []byte) []byte {
+func (t tag) appendStart(buf []byte) []byte {
 	buf = append(buf, '<')
 	buf = append(buf, t.name...)
 	if t.id != `` {
@@ -179,15 +295,15 @@ func (t tag) AppendHTML(buf []byte) []byte {
 		buf = append(buf, attr.head...)
 		if len(attr.tail) > 0 {
 			buf = append(buf, '=', '\'')
-			buf = append(buf, attr.tail...)
+			buf = html.AppendAttrText(buf, attr.tail)
 			buf = append(buf, '\'')
 		}
 	}
-	buf = append(buf, '>')
-	for _, content := range t.content {
-		buf = content.AppendHTML(buf)
-	}
-	if t.void {
+	return append(buf, '>')
+}
+
+func (t tag) appendEnd(buf []byte) []byte {
+	if t.kind == voidKind {
 		return buf
 	}
 	buf = append(buf, '<', '/')
@@ -196,6 +312,15 @@ func (t tag) AppendHTML(buf []byte) []byte {
 	return buf
 }
 
+func (t tag) TagName() string { return t.name }
+
+func (t tag) Children() []html.Content { return slices.Clone(t.content) }
+
+func (t tag) WithChildren(children ...html.Content) html.Node {
+	t.content = nil
+	return t.Add(children...)
+}
+
 func (t tag) ID() string { return t.id }
 
 func (t tag) Class(classes ...string) Interface {
@@ -204,33 +329,32 @@ func (t tag) Class(classes ...string) Interface {
 }
 
 func (t tag) Set(head string, values ...any) Interface {
-	tail := make([]byte, 0, 64)
+	var prefix string
 	if ix := strings.IndexByte(head, '='); ix > -1 {
-		tail = appendValueStr(tail, head[ix+1:])
+		prefix = head[ix+1:]
 		head = head[:ix]
 	}
-	for _, value := range values {
-		tail = appendValue(tail, value)
+	if prefix != `` {
+		values = append([]any{html.HTML(prefix)}, values...)
 	}
+	tail := html.AttrValue(head, values...)
 	switch head {
 	case `class`:
 		// as a special case, if class is set, we replace the existing classes
-		t.classes = []string{string(tail)}
+		t.classes = []string{tail}
 		return t
 	case `id`:
-		t.id = string(tail)
+		t.id = tail
 		return t
 	}
 
-	for i := range t.attributes {
-		if t.attributes[i].head == head {
-			// copy the attributes so we do not modify the original
-			t.attributes = append([]attribute(nil), t.attributes...)
-			t.attributes[i].tail = string(tail)
-			return t
-		}
+	if i := t.indexAttr(head); i >= 0 {
+		// copy the attributes so we do not modify the original
+		t.attributes = append([]attribute(nil), t.attributes...)
+		t.attributes[i].tail = tail
+		return t
 	}
-	t.attributes = extend(t.attributes, attribute{head: head, tail: string(tail)})
+	t.attributes = extend(t.attributes, attribute{head: head, tail: tail})
 	return t
 }
 
@@ -244,30 +368,41 @@ func (t tag) Text(data ...any) Interface { return t.Add(html.Text(fmt.Sprint(dat
 
 func (t tag) Defer(fn func() html.Content) Interface { return t.Add(html.Func(fn)) }
 
+func (t tag) DeferContext(fn func(context.Context) html.Content) Interface {
+	return t.Add(html.ContextFunc(fn))
+}
+
 func (t tag) Add(content ...html.Content) Interface {
+	for _, item := range content {
+		if _, ok := item.(html.Attr); ok {
+			return t.addAttrs(content)
+		}
+		t.checkContent(item)
+	}
 	t.content = extend(t.content, content...)
 	return t
 }
 
-type attribute struct {
-	head string
-	tail string
-}
-
-func appendValue(buf []byte, value any) []byte       { return appendValueStr(buf, fmt.Sprint(value)) }
-func appendValueStr(buf []byte, value string) []byte { return appendValueBytes(buf, []byte(value)) }
-func appendValueBytes(buf []byte, value []byte) []byte {
-	for _, ch := range value {
-		switch ch {
-		case '\'':
-			buf = append(buf, `&apos;`...)
-		case '&':
-			buf = append(buf, `&amp;`...)
-		default:
-			buf = append(buf, ch)
+// addAttrs adds content to the tag, moving any html.Attr fragments into the start tag.
+func (t tag) addAttrs(content []html.Content) Interface {
+	t.attributes = extend(t.attributes)
+	t.content = extend(t.content)
+	for _, item := range content {
+		if attr, ok := item.(html.Attr); ok {
+			// the fragment is added verbatim as the head of an attribute without a tail.
+			t.attributes = append(t.attributes, attribute{head: string(attr), fragment: true})
+		} else {
+			t.checkContent(item)
+			t.content = append(t.content, item)
 		}
 	}
-	return buf
+	return t
+}
+
+type attribute struct {
+	head     string
+	tail     string // the value of the attribute, which is escaped when it is appended.
+	fragment bool   // if true, head is a html.Attr fragment and tail is empty.
 }
 
 // extend is a helper function to extend a slice without using the capacity of the slice.
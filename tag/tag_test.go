@@ -1,6 +1,11 @@
 package tag
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/swdunlop/html-go"
+)
 
 func Test(t *testing.T) {
 	test(t, `Empty`, `<div></div>`, func() Interface {
@@ -63,3 +68,283 @@ func test(t *testing.T, name string, expect string, do func() Interface) {
 		}
 	})
 }
+
+func TestSetSanitizes(t *testing.T) {
+	test(t, `UnsafeHref`, `<a href='#ZgotmplZ'>x</a>`, func() Interface {
+		return New(`a`).Set(`href`, `javascript:alert(1)`).Text(`x`)
+	})
+	test(t, `ObfuscatedHref`, `<a href='#ZgotmplZ'>x</a>`, func() Interface {
+		return New(`a`).Set(`href`, " JaVa\tScRiPt:alert(1)").Text(`x`)
+	})
+	test(t, `RelativeHref`, `<a href='/users/javascript:alert(1)'>x</a>`, func() Interface {
+		return New(`a`).Set(`href=/users/`, `javascript:alert(1)`).Text(`x`)
+	})
+	test(t, `MailtoHref`, `<a href='mailto:bob@example.com'>x</a>`, func() Interface {
+		return New(`a`).Set(`href`, `mailto:bob@example.com`).Text(`x`)
+	})
+	test(t, `TrustedHref`, `<a href='javascript:void(0)'>x</a>`, func() Interface {
+		return New(`a`).Set(`href`, html.HTML(`javascript:void(0)`)).Text(`x`)
+	})
+	test(t, `UnsafeSrcset`, `<img srcset='#ZgotmplZ'>`, func() Interface {
+		return New(`img`).Set(`srcset`, `a.png 1x, javascript:alert(1) 2x`)
+	})
+	test(t, `HandlerString`, `<button onclick='greet("Bob\u0027); alert(\u00271")'>x</button>`, func() Interface {
+		return New(`button`).Set(`onclick=greet(`, `Bob'); alert('1`, html.HTML(`)`)).Text(`x`)
+	})
+	test(t, `HandlerValue`, `<button onclick='select(42)'>x</button>`, func() Interface {
+		return New(`button`).Set(`onclick=select(`, 42, html.HTML(`)`)).Text(`x`)
+	})
+	test(t, `HandlerScriptEnd`, `<button onclick='"\u003c/script\u003e"'>x</button>`, func() Interface {
+		return New(`button`).Set(`onclick`, `</script>`).Text(`x`)
+	})
+	test(t, `UnknownHandler`, `<input onsearch='"alert(document.cookie)"'>`, func() Interface {
+		return New(`input`).Set(`onsearch`, `alert(document.cookie)`)
+	})
+	test(t, `PointerHandler`, `<div onpointerdown='"x"'></div>`, func() Interface {
+		return New(`div`).Set(`onpointerdown`, `x`)
+	})
+	test(t, `SafeStyle`, `<div style='color: red'></div>`, func() Interface {
+		return New(`div`).Set(`style=color: `, `red`)
+	})
+	test(t, `UnsafeStyle`, `<div style='color: ZgotmplZ'></div>`, func() Interface {
+		return New(`div`).Set(`style=color: `, `red; background: url(javascript:alert(1))`)
+	})
+	test(t, `ExpressionStyle`, `<div style='width: ZgotmplZ'></div>`, func() Interface {
+		return New(`div`).Set(`style=width: `, `expression(alert(1))`)
+	})
+	test(t, `PlainAttribute`, `<input value='&apos;javascript:&apos;'>`, func() Interface {
+		return New(`input`).Set(`value`, `'javascript:'`)
+	})
+}
+
+func TestTrustedValues(t *testing.T) {
+	test(t, `TrustedURL`, `<a href='javascript:void(0)'>x</a>`, func() Interface {
+		return New(`a`).Set(`href`, html.URL(`javascript:void(0)`)).Text(`x`)
+	})
+	test(t, `SafeURL`, `<a href='#ZgotmplZ'>x</a>`, func() Interface {
+		return New(`a`).Set(`href`, html.SafeURL(`javascript:alert(1)`)).Text(`x`)
+	})
+	test(t, `URLInHandler`, `<a onclick='go("/home")'>x</a>`, func() Interface {
+		return New(`a`).Set(`onclick`, html.JS(`go(`), html.URL(`/home`), html.JS(`)`)).Text(`x`)
+	})
+	test(t, `TrustedJS`, `<button onclick='greet(&apos;Bob&apos;)'>x</button>`, func() Interface {
+		return New(`button`).Set(`onclick`, html.JS(`greet('Bob')`)).Text(`x`)
+	})
+	test(t, `JSString`, `<button onclick='greet("Bob\u0027s")'>x</button>`, func() Interface {
+		return New(`button`).Set(`onclick`, html.JS(`greet(`), html.JSString(`Bob's`), html.JS(`)`)).Text(`x`)
+	})
+	test(t, `JSInHref`, `<a href='#ZgotmplZ'>x</a>`, func() Interface {
+		return New(`a`).Set(`href`, html.JS(`javascript:alert(1)`)).Text(`x`)
+	})
+	test(t, `TrustedCSS`, `<div style='background: url(/bg.png)'></div>`, func() Interface {
+		return New(`div`).Set(`style`, html.CSS(`background: url(/bg.png)`))
+	})
+	test(t, `SafeCSS`, `<div style='color: ZgotmplZ'></div>`, func() Interface {
+		return New(`div`).Set(`style=color: `, html.SafeCSS(`red; position: fixed`))
+	})
+	test(t, `AddAttr`, `<div dir='ltr' title='it&apos;s'>text</div>`, func() Interface {
+		return New(`div`).Add(html.Attr(`dir='ltr'`), html.Text(`text`), html.Attribute(`title`, `it's`))
+	})
+	test(t, `AddAttrUnsafeURL`, `<a href='#ZgotmplZ'></a>`, func() Interface {
+		return New(`a`).Add(html.Attribute(`href`, `javascript:alert(1)`))
+	})
+}
+
+func TestRawText(t *testing.T) {
+	test(t, `ScriptText`, `<script>if (a < b && c > d) alert("hi")</script>`, func() Interface {
+		return New(`script`).Text(`if (a < b && c > d) alert("hi")`)
+	})
+	test(t, `ScriptEnd`, `<script>var s = "<\/SCRIPT><\script>alert(1)<\!--";</script>`, func() Interface {
+		return New(`script`).Add(html.JS(`var s = "</SCRIPT><script>alert(1)<!--";`))
+	})
+	test(t, `StyleEnd`, `<style>p::after { content: "<\/style>"; }</style>`, func() Interface {
+		return New(`style`).Add(html.CSS(`p::after { content: "</style>"; }`))
+	})
+	test(t, `JSOutsideScript`, `<div>&lt;/div&gt;&lt;img src=x onerror=alert(1)&gt;</div>`, func() Interface {
+		return New(`div`).Add(html.JS(`</div><img src=x onerror=alert(1)>`))
+	})
+	test(t, `CSSOutsideStyle`, `<p>&lt;/p&gt;&lt;b&gt;</p>`, func() Interface {
+		return New(`p`).Add(html.CSS(`</p><b>`))
+	})
+	test(t, `StyleKeepsOtherTags`, `<style>p::after { content: "</p>"; }</style>`, func() Interface {
+		return New(`style`).Text(`p::after { content: "</p>"; }`)
+	})
+	test(t, `TextareaText`, `<textarea>&lt;/textarea&gt;&amp;</textarea>`, func() Interface {
+		return New(`textarea`).Text(`</textarea>&`)
+	})
+	test(t, `TitleText`, `<title>A &amp; B</title>`, func() Interface {
+		return New(`title`, html.Text(`A & B`))
+	})
+
+	for name, add := range map[string]func(){
+		`ScriptHTML`:    func() { New(`script`).HTML(`alert(1)`) },
+		`ScriptCSS`:     func() { New(`script`).Add(html.CSS(`p {}`)) },
+		`StyleJS`:       func() { New(`style`).Add(html.JS(`alert(1)`)) },
+		`StyleTag`:      func() { New(`style`, New(`p`)) },
+		`TextareaTag`:   func() { New(`textarea`).Add(New(`b`)) },
+		`TitleURL`:      func() { New(`title`).Add(html.URL(`/home`)) },
+		`TitleDeferred`: func() { New(`title`).Defer(func() html.Content { return html.Text(`x`) }) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error(`expected Add to panic`)
+				}
+			}()
+			add()
+		})
+	}
+}
+
+func TestSelectors(t *testing.T) {
+	test(t, `QuotedValue`, `<input placeholder='Your Name'>`, func() Interface {
+		return New(`input[placeholder="Your Name"]`)
+	})
+	test(t, `QuotedBracket`, `<input title='a ] b' required>`, func() Interface {
+		return New(`input[title="a ] b"][required]`)
+	})
+	test(t, `EscapedValue`, `<input value='it&apos;s &amp; that'>`, func() Interface {
+		return New(`input[value="it's & that"]`)
+	})
+	test(t, `StaticClasses`, `<div class='one two three'></div>`, func() Interface {
+		return New(`div.one[class="two three"]`)
+	})
+	test(t, `SingleQuotedValue`, `<a href='/terms'>terms</a>`, func() Interface {
+		return New(`a[href='/terms']`).Text(`terms`)
+	})
+	test(t, `ReadmeSelector`, `<input type='text' name='username' placeholder='Your-User-Name'>`, func() Interface {
+		return New(`input[type=text][name=username][placeholder="Your-User-Name"]`)
+	})
+	test(t, `NestedQuotes`, `<input title='Say &apos;Hi&apos; "there"'>`, func() Interface {
+		return New(`input[title="Say 'Hi' \"there\""]`)
+	})
+	test(t, `HexEscape`, `<input title='a b]c'>`, func() Interface {
+		return New(`input[title='a\20 b\]c']`)
+	})
+	test(t, `UnquotedEscape`, `<input title='a b'>`, func() Interface {
+		return New(`input[title=a\ b]`)
+	})
+	test(t, `CustomElement`, `<my-widget id='w1'></my-widget>`, func() Interface {
+		return MustCompile(`my-widget#w1`)
+	})
+
+	for _, selector := range []string{
+		`di v`,
+		`1div`,
+		`div#`,
+		`div.`,
+		`div..one`,
+		`div#one#two`,
+		`div#one[id=two]`,
+		`div[]`,
+		`div[=one]`,
+		`div[na me]`,
+		`div[title=a b]`,
+		`div[title="a b]`,
+		`div[title="a"b]`,
+		`div[title='a]`,
+		`div[title='a"]`,
+		`div[title=a'b]`,
+		`div[title`,
+		`div]`,
+		`<div>`,
+	} {
+		t.Run(selector, func(t *testing.T) {
+			_, err := Compile(selector)
+			if err == nil {
+				t.Fatalf(`expected %q to be rejected`, selector)
+			}
+			t.Log(err)
+		})
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	base := New(`button#save.btn.primary[type=submit][disabled]`).Set(`title`, `Save & Close`)
+	test(t, `Unset`, `<button class='btn primary' type='submit'></button>`, func() Interface {
+		return base.Unset(`id`, `disabled`, `title`)
+	})
+	test(t, `UnsetMissing`, `<br>`, func() Interface {
+		return New(`br`).Unset(`class`, `title`)
+	})
+	test(t, `RemoveClass`, `<div class='a c'></div>`, func() Interface {
+		return New(`div.a.b`).Class(`c`, `b`).RemoveClass(`b`)
+	})
+	test(t, `SetIf`, `<input checked>`, func() Interface {
+		return New(`input`).SetIf(true, `checked`).SetIf(false, `value`, `no`)
+	})
+	test(t, `Toggle`, `<button type='submit'>Save</button>`, func() Interface {
+		return base.Unset(`id`, `class`, `title`).Toggle(`disabled`, false).Toggle(`hidden`, false).Text(`Save`)
+	})
+	test(t, `ToggleOn`, `<details open></details>`, func() Interface {
+		return New(`details`).Toggle(`open`, true)
+	})
+	test(t, `SetIDEscapesOnce`, `<div id='a&amp;b'></div>`, func() Interface {
+		return New(`div`).Set(`id`, `a&b`)
+	})
+	test(t, `Unchanged`, `<button id='save' class='btn primary' type='submit' disabled title='Save &amp; Close'></button>`,
+		func() Interface { return base })
+
+	for name, expect := range map[string]string{
+		`id`: `save`, `class`: `btn primary`, `type`: `submit`, `disabled`: ``, `title`: `Save & Close`,
+	} {
+		if value, ok := base.Attr(name); !ok || value != expect {
+			t.Errorf(`expected Attr(%q) to be %q, got %q, %v`, name, expect, value, ok)
+		}
+	}
+	if _, ok := base.Attr(`href`); ok {
+		t.Error(`expected Attr("href") to be missing`)
+	}
+
+	var names []string
+	for name := range base.Add(html.Attr(`dir='ltr'`)).Attributes() {
+		names = append(names, name)
+	}
+	if got, expect := strings.Join(names, ` `), `id class type disabled title`; got != expect {
+		t.Errorf(`expected attributes %q, got %q`, expect, got)
+	}
+}
+
+func TestStructuredAttributes(t *testing.T) {
+	test(t, `Data`, `<div data-count='3' data-on-click='$count++' data-signals='{"count":3,"name":"it&apos;s"}'></div>`,
+		func() Interface {
+			return New(`div`).Data(map[string]any{
+				`signals`:  map[string]any{`count`: 3, `name`: `it's`},
+				`on-click`: `$count++`,
+				`count`:    3,
+			})
+		})
+	test(t, `Aria`, `<button aria-expanded='false' aria-label='Close &amp; save'></button>`, func() Interface {
+		return New(`button`).Aria(`expanded`, false).Aria(`aria-label`, `Close & save`)
+	})
+	test(t, `Style`, `<div style='--accent: #f80; color: red; width: ZgotmplZ'></div>`, func() Interface {
+		return New(`div`).Style(map[string]string{
+			`color`:    `red`,
+			`width`:    `expression(alert(1))`,
+			`--accent`: `#f80`,
+		})
+	})
+	test(t, `Immutable`, `<div></div>`, func() Interface {
+		base := New(`div`)
+		base.Data(map[string]any{`x`: 1})
+		base.Aria(`hidden`, true)
+		base.Style(map[string]string{`color`: `red`})
+		return base
+	})
+
+	for name, set := range map[string]func(){
+		`UnknownAria`:     func() { New(`div`).Aria(`expandable`, true) },
+		`InvalidAria`:     func() { New(`div`).Aria(`expanded`, `yes`) },
+		`InvalidData`:     func() { New(`div`).Data(map[string]any{`a b`: 1}) },
+		`InvalidProperty`: func() { New(`div`).Style(map[string]string{`color;x`: `red`}) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error(`expected a panic`)
+				}
+			}()
+			set()
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package tag
+
+import (
+	"fmt"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/internal/rawtext"
+)
+
+// checkContent panics if the content cannot be added to the tag because of the HTML5 rules for raw text and escapable
+// raw text elements.
+func (t tag) checkContent(content html.Content) {
+	switch t.kind {
+	case rawTextKind:
+		switch content.(type) {
+		case html.Text:
+			return
+		case html.JS:
+			if t.name == `script` {
+				return
+			}
+		case html.CSS:
+			if t.name == `style` {
+				return
+			}
+		}
+		panic(fmt.Errorf(`%v tags can only contain html.Text, html.JS or html.CSS content, not %T`, t.name, content))
+	case rcdataKind:
+		if _, ok := content.(html.Text); ok {
+			return
+		}
+		panic(fmt.Errorf(`%v tags can only contain html.Text content, not %T`, t.name, content))
+	}
+}
+
+// appendRawText appends the content of a script or style tag, escaped with rawtext.Append.
+func (t tag) appendRawText(buf []byte) []byte {
+	for _, content := range t.content {
+		var text string
+		switch content := content.(type) {
+		case html.Text:
+			text = string(content)
+		case html.JS:
+			text = string(content)
+		case html.CSS:
+			text = string(content)
+		}
+		buf = rawtext.Append(buf, t.name, text)
+	}
+	return buf
+}
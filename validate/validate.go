@@ -0,0 +1,375 @@
+// Package validate checks rendered HTML for mistakes that browsers silently tolerate, but that break pages in subtle
+// ways, like duplicate IDs that confuse htmx and Alpine AJAX targets, a "div" inside a "p", which the browser moves out
+// of the paragraph, or an "img" without "alt" text.  It is meant for tests and development servers, not production.
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/internal/capture"
+	"github.com/swdunlop/html-go/internal/scan"
+	"github.com/swdunlop/html-go/internal/whatwg"
+)
+
+// A Problem describes a mistake found in HTML.
+type Problem struct {
+	Offset  int    // Offset is the offset of the problem in the rendered HTML.
+	Tag     string // Tag is the start tag where the problem was found, if any, shortened if it is long.
+	Message string // Message describes the problem, like "duplicate id "main"".
+}
+
+// String returns the problem as a single line, like `offset 42: <p id="a">: duplicate id "a"`.
+func (p Problem) String() string {
+	if p.Tag == `` {
+		return fmt.Sprintf(`offset %v: %v`, p.Offset, p.Message)
+	}
+	return fmt.Sprintf(`offset %v: %v: %v`, p.Offset, p.Tag, p.Message)
+}
+
+// An Option changes what is checked.
+type Option func(*config)
+
+// RequireIDs reports a problem for each ID that does not appear in the HTML, such as the ID of a part that htmx or
+// Alpine AJAX will ask for.
+func RequireIDs(ids ...string) Option {
+	return func(cfg *config) { cfg.required = append(cfg.required, ids...) }
+}
+
+// RequireParts is like RequireIDs, using the ID of each part, like the parts passed to htmx.RenderPage or
+// alpine.RenderPage.
+func RequireParts[P interface{ ID() string }](parts ...P) Option {
+	return func(cfg *config) {
+		for _, part := range parts {
+			cfg.required = append(cfg.required, part.ID())
+		}
+	}
+}
+
+type config struct {
+	required []string
+}
+
+// Check renders the content and returns an error listing any problems, or nil if there are none, which is convenient
+// in tests.
+func Check(content html.Content, options ...Option) error {
+	problems := Content(context.Background(), content, options...)
+	if len(problems) == 0 {
+		return nil
+	}
+	errs := make([]error, len(problems))
+	for i, problem := range problems {
+		errs[i] = errors.New(problem.String())
+	}
+	return errors.Join(errs...)
+}
+
+// Content renders the content with ctx and returns any problems found in the HTML.
+func Content(ctx context.Context, content html.Content, options ...Option) []Problem {
+	return HTML(html.AppendContext(ctx, nil, content), options...)
+}
+
+// HTML returns any problems found in HTML that has already been rendered.  It reports:
+//
+//   - IDs that are used by more than one element, are empty or contain whitespace;
+//   - elements that cannot be nested, like block elements such as "div" inside a "p", a "form" inside another
+//     "form", interactive elements like "button" inside an "a" or "button", and "li" outside of a list;
+//   - elements that are not in the HTML spec, except for custom elements, whose names contain "-", and elements
+//     inside "svg" or "math";
+//   - elements missing a required attribute, like "img" without "alt";
+//   - end tags that do not match an open element, and elements that are not closed, except for elements whose end
+//     tag is optional, like "li".
+func HTML(src []byte, options ...Option) []Problem {
+	var cfg config
+	for _, option := range options {
+		option(&cfg)
+	}
+	v := validator{ids: make(map[string]int)}
+	s := scan.New(string(src))
+	for {
+		offset := s.Offset()
+		tok, ok := s.Next()
+		if !ok {
+			break
+		}
+		switch tok.Kind {
+		case scan.StartTag:
+			v.start(offset, tok)
+		case scan.EndTag:
+			v.end(offset, tok)
+		}
+	}
+	for _, open := range v.stack {
+		if !whatwg.OptionalEnd(open.name) {
+			v.report(open.offset, open.raw, `<%v> is not closed`, open.name)
+		}
+	}
+	for _, id := range cfg.required {
+		if _, ok := v.ids[id]; !ok {
+			v.report(len(src), ``, `no element has the id %q`, id)
+		}
+	}
+	return v.problems
+}
+
+// Middleware returns middleware that checks each HTML response and logs any problems as warnings, using the logger
+// from zerolog.Ctx, like the one added by hog.Middleware.  The response is sent unchanged, but must be buffered, so
+// this should only be used during development.
+func Middleware(options ...Option) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rsp := capture.New(w)
+			next.ServeHTTP(rsp, r)
+			body := rsp.Body.Bytes()
+			if rsp.HTML() {
+				log := zerolog.Ctx(r.Context())
+				for _, problem := range HTML(body, options...) {
+					log.Warn().Str(`path`, r.URL.Path).Int(`offset`, problem.Offset).Str(`tag`, problem.Tag).
+						Msg(problem.Message)
+				}
+			}
+			_ = rsp.Send(w, body)
+		})
+	}
+}
+
+type validator struct {
+	stack    []element
+	implied  []implied
+	ids      map[string]int // the offset where each ID was first used
+	problems []Problem
+}
+
+// implied records elements closed by a start tag, like a "p" closed by a "div", so an end tag that follows for one of
+// them can be reported as invalid nesting.
+type implied struct {
+	names    []string // the names of the closed elements, starting with the one the start tag ended
+	by       element  // the start tag that closed them
+	reported bool
+}
+
+type element struct {
+	name   string
+	raw    string // the start tag, shortened by shorten
+	offset int
+	elem   *whatwg.Element
+}
+
+func (v *validator) report(offset int, raw string, format string, args ...any) {
+	v.problems = append(v.problems, Problem{Offset: offset, Tag: raw, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) start(offset int, tok scan.Token) {
+	name := strings.ToLower(tok.Name)
+	raw := shorten(tok.Raw)
+	if id, ok := tok.Attr(`id`); ok {
+		v.checkID(offset, raw, id)
+	}
+	elem, known := whatwg.Lookup(name)
+	foreign := v.foreign()
+	switch {
+	case foreign:
+	case !known && !strings.Contains(name, `-`):
+		v.report(offset, raw, `<%v> is not an HTML element`, name)
+	case known && elem.Obsolete:
+		v.report(offset, raw, `<%v> is obsolete`, name)
+	}
+	if known && !foreign {
+		for _, attr := range elem.Attributes {
+			if _, ok := tok.Attr(attr.Name); attr.Required && !ok {
+				v.report(offset, raw, `<%v> is missing the required %v attribute`, name, attr.Name)
+			}
+		}
+		v.implyEnd(element{name: name, raw: raw, offset: offset, elem: elem})
+		v.checkNesting(offset, raw, name, tok)
+	}
+	if tok.SelfClosing && (foreign || name == `svg` || name == `math`) || known && elem.Void {
+		return
+	}
+	v.stack = append(v.stack, element{name: name, raw: raw, offset: offset, elem: elem})
+}
+
+func (v *validator) checkID(offset int, raw, id string) {
+	switch {
+	case id == ``:
+		v.report(offset, raw, `id is empty`)
+		return
+	case strings.ContainsAny(id, " \t\n\r\f"):
+		v.report(offset, raw, `id %q contains whitespace`, id)
+	}
+	if first, dup := v.ids[id]; dup {
+		v.report(offset, raw, `duplicate id %q, first used at offset %v`, id, first)
+		return
+	}
+	v.ids[id] = offset
+}
+
+// implyEnd closes elements whose end tag is implied by a start tag, like a "p" before a "div", the way browsers do,
+// so HTML that leaves out optional end tags, like the output of html.Minify, is not reported.
+func (v *validator) implyEnd(by element) {
+	for _, end := range whatwg.ImpliedEnds(by.name) {
+		if end.Current {
+			if n := len(v.stack); n > 0 && slices.Contains(end.Names, v.stack[n-1].name) {
+				v.close(by, n-1)
+			}
+			continue
+		}
+		v.closeNearest(by, end.Names, end.Boundaries)
+	}
+}
+
+// closeNearest closes the innermost open element with one of the names, unless one of the boundaries, or an element
+// like "table" that starts a new scope, is open inside it.
+func (v *validator) closeNearest(by element, names, boundaries []string) {
+	for i := len(v.stack) - 1; i >= 0; i-- {
+		name := v.stack[i].name
+		switch {
+		case slices.Contains(names, name):
+			v.close(by, i)
+			return
+		case whatwg.Scope(name), slices.Contains(boundaries, name):
+			return
+		}
+	}
+}
+
+// close closes the open element at index i, and the elements open inside it, because by implied their end.
+func (v *validator) close(by element, i int) {
+	closed := implied{by: by}
+	for j := i; j < len(v.stack); j++ {
+		closed.names = append(closed.names, v.stack[j].name)
+	}
+	v.implied = append(v.implied, closed)
+	v.stack = v.stack[:i]
+}
+
+func (v *validator) checkNesting(offset int, raw, name string, tok scan.Token) {
+	if name == `form` && v.open(`form`) {
+		v.report(offset, raw, `<form> cannot be inside another <form>`)
+	}
+	if interactive(name, tok) {
+		for _, outer := range []string{`a`, `button`} {
+			if v.open(outer) {
+				v.report(offset, raw, `<%v> cannot be inside <%v>, which is interactive`, name, outer)
+			}
+		}
+	}
+	parent := ``
+	if n := len(v.stack); n > 0 {
+		parent = v.stack[n-1].name
+	}
+	// the content of a template is a separate document fragment, which may be inserted into a list, so elements at
+	// its top level can be anything.
+	switch name {
+	case `li`:
+		if parent != `ul` && parent != `ol` && parent != `menu` && parent != `template` {
+			v.report(offset, raw, `<li> must be inside <ul>, <ol> or <menu>`)
+		}
+	case `dt`, `dd`:
+		grandparent := v.grandparent()
+		inDiv := parent == `div` && (grandparent == `dl` || grandparent == `template`)
+		if parent != `dl` && parent != `template` && !inDiv {
+			v.report(offset, raw, `<%v> must be inside <dl>, or a <div> inside <dl>`, name)
+		}
+	}
+}
+
+// grandparent returns the name of the element that contains the innermost open element, if any.
+func (v *validator) grandparent() string {
+	if n := len(v.stack); n > 1 {
+		return v.stack[n-2].name
+	}
+	return ``
+}
+
+// open returns true if an element with the name is open, stopping at elements like "table" that start a new scope.
+func (v *validator) open(name string) bool {
+	for i := len(v.stack) - 1; i >= 0; i-- {
+		switch {
+		case v.stack[i].name == name:
+			return true
+		case whatwg.Scope(v.stack[i].name):
+			return false
+		}
+	}
+	return false
+}
+
+// foreign returns true if an "svg" or "math" element is open.
+func (v *validator) foreign() bool {
+	for _, open := range v.stack {
+		if open.elem != nil && open.elem.Foreign {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *validator) end(offset int, tok scan.Token) {
+	name := strings.ToLower(tok.Name)
+	for i := len(v.stack) - 1; i >= 0; i-- {
+		if v.stack[i].name != name && !strings.EqualFold(v.stack[i].name, tok.Name) {
+			continue
+		}
+		for _, open := range v.stack[i+1:] {
+			if !whatwg.OptionalEnd(open.name) {
+				v.report(open.offset, open.raw, `<%v> is not closed before </%v>`, open.name, name)
+			}
+		}
+		v.stack = v.stack[:i]
+		return
+	}
+	// an end tag for an element that was closed by a start tag means the author meant to nest it there.
+	for i := len(v.implied) - 1; i >= 0; i-- {
+		closed := &v.implied[i]
+		j := slices.Index(closed.names, name)
+		if j < 0 {
+			continue
+		}
+		if !closed.reported {
+			closed.reported = true
+			by, outer := closed.by, closed.names[0]
+			v.report(by.offset, by.raw, `<%v> cannot be inside <%v>, browsers will end the <%v> before it`,
+				by.name, outer, outer)
+		}
+		closed.names[j] = `` // each closed element only matches one end tag.
+		return
+	}
+	v.report(offset, ``, `</%v> does not match an open element`, name)
+}
+
+// interactive returns true if the element is interactive content, which cannot be nested in "a" or "button".
+func interactive(name string, tok scan.Token) bool {
+	switch name {
+	case `a`:
+		_, ok := tok.Attr(`href`)
+		return ok
+	case `input`:
+		typ, _ := tok.Attr(`type`)
+		return !strings.EqualFold(typ, `hidden`)
+	case `audio`, `video`:
+		_, ok := tok.Attr(`controls`)
+		return ok
+	case `img`:
+		_, ok := tok.Attr(`usemap`)
+		return ok
+	}
+	elem, ok := whatwg.Lookup(name)
+	return ok && elem.Interactive
+}
+
+// shorten returns the start tag, shortened to keep problems readable.
+func shorten(raw string) string {
+	const limit = 60
+	if len(raw) <= limit {
+		return raw
+	}
+	return raw[:limit-4] + ` ...`
+}
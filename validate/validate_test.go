@@ -0,0 +1,102 @@
+package validate_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/swdunlop/html-go"
+	"github.com/swdunlop/html-go/tag"
+	"github.com/swdunlop/html-go/validate"
+)
+
+func TestHTML(t *testing.T) {
+	test := func(name, src string, expect ...string) {
+		t.Helper()
+		problems := validate.HTML([]byte(src))
+		if len(problems) != len(expect) {
+			t.Errorf("%v: expected %v problems, got %v: %v", name, len(expect), len(problems), problems)
+			return
+		}
+		for i, problem := range problems {
+			if result := problem.String(); result != expect[i] {
+				t.Errorf("%v: expected %q, got %q", name, expect[i], result)
+			}
+		}
+	}
+	test(`valid`, `<main id="main"><p>Hello, <a href="/">World</a>!</p><img src="a.png" alt="A"></main>`)
+	test(`duplicate id`, `<div id="a"></div><p id="a"></p>`,
+		`offset 18: <p id="a">: duplicate id "a", first used at offset 0`)
+	test(`empty id`, `<div id=""></div>`, `offset 0: <div id="">: id is empty`)
+	test(`div in p`, `<p>One<div>Two</div></p>`,
+		`offset 6: <div>: <div> cannot be inside <p>, browsers will end the <p> before it`)
+	test(`omitted end of p`, `<p>One<p>Two<div>Three</div>`)
+	test(`omitted end of li`, `<ul><li>One<li>Two</ul>`)
+	test(`form in form`, `<form><form></form></form>`,
+		`offset 6: <form>: <form> cannot be inside another <form>`)
+	test(`button in a`, `<a href="/"><button>Go</button></a>`,
+		`offset 12: <button>: <button> cannot be inside <a>, which is interactive`)
+	test(`li outside list`, `<div><li>One</li></div>`, `offset 5: <li>: <li> must be inside <ul>, <ol> or <menu>`)
+	test(`dt in div in dl`, `<dl><div><dt>Term<dd>Definition</div></dl>`)
+	test(`li in template`, `<template><li>One</li></template>`)
+	test(`dt in template`, `<template><div><dt>Term</dt><dd>Definition</dd></div><dt>A</dt></template>`)
+	test(`li in div in template`, `<template><div><li>One</li></div></template>`,
+		`offset 15: <li>: <li> must be inside <ul>, <ol> or <menu>`)
+	test(`dt in div`, `<div><dt>Term</dt></div>`,
+		`offset 5: <dt>: <dt> must be inside <dl>, or a <div> inside <dl>`)
+	test(`unknown`, `<blink>Hi</blink><my-widget></my-widget>`, `offset 0: <blink>: <blink> is not an HTML element`)
+	test(`svg`, `<svg viewBox="0 0 1 1"><path d="M0 0"/><linearGradient></linearGradient></svg>`)
+	test(`img without alt`, `<img src="a.png">`, `offset 0: <img src="a.png">: <img> is missing the required alt attribute`)
+	test(`unclosed`, `<div><span>Hi</div>`, `offset 5: <span>: <span> is not closed before </div>`)
+	test(`stray end`, `<div></div></span>`, `offset 11: </span> does not match an open element`)
+}
+
+func TestCheck(t *testing.T) {
+	page := tag.New(`main#main`).Add(tag.New(`div#list`), tag.New(`div#list`))
+	err := validate.Check(page)
+	if err == nil || !strings.Contains(err.Error(), `duplicate id "list"`) {
+		t.Errorf("expected a duplicate id error, got %v", err)
+	}
+	if err := validate.Check(tag.New(`main#main`).Add(tag.New(`div#list`))); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	err = validate.Check(page, validate.RequireIDs(`main`, `detail`))
+	if err == nil || !strings.Contains(err.Error(), `no element has the id "detail"`) {
+		t.Errorf("expected a missing id error, got %v", err)
+	}
+}
+
+func TestMinified(t *testing.T) {
+	page := tag.New(`main`).Add(
+		tag.New(`p`).Text(`One`),
+		tag.New(`div`).Add(tag.New(`ul`).Add(tag.New(`li`).Text(`A`), tag.New(`li`).Text(`B`))),
+	)
+	if problems := validate.Content(html.Minify(context.Background()), page); len(problems) > 0 {
+		t.Errorf("expected no problems in %s, got %v", html.AppendContext(html.Minify(context.Background()), nil, page),
+			problems)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	body := html.Append(nil, tag.New(`div#a`), tag.New(`div#a`))
+	handler := validate.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(`Content-Type`, `text/html; charset=utf-8`)
+		_, _ = w.Write(body)
+	}))
+	var log bytes.Buffer
+	r := httptest.NewRequest(`GET`, `/page`, nil)
+	r = r.WithContext(zerolog.New(&log).WithContext(r.Context()))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Body.String() != string(body) {
+		t.Errorf("expected the body to be unchanged, got %q", w.Body.String())
+	}
+	if !strings.Contains(log.String(), `duplicate id \"a\"`) {
+		t.Errorf("expected a warning about the duplicate id, got %q", log.String())
+	}
+}